@@ -1,39 +1,259 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"log"
 
+	"CalculatorAppFrontendPantela-main/internal/authService"
 	"CalculatorAppFrontendPantela-main/internal/calculationService"
+	"CalculatorAppFrontendPantela-main/internal/currencyService"
 	"CalculatorAppFrontendPantela-main/internal/db"
+	"CalculatorAppFrontendPantela-main/internal/functionService"
 	"CalculatorAppFrontendPantela-main/internal/handlers"
+	"CalculatorAppFrontendPantela-main/internal/userService"
+	"CalculatorAppFrontendPantela-main/internal/variableService"
+	"CalculatorAppFrontendPantela-main/internal/web/auth"
+	"CalculatorAppFrontendPantela-main/internal/web/functions"
+	"CalculatorAppFrontendPantela-main/internal/web/rates"
 	"CalculatorAppFrontendPantela-main/internal/web/tasks"
+	"CalculatorAppFrontendPantela-main/internal/web/users"
+	"CalculatorAppFrontendPantela-main/internal/web/variables"
+)
+
+// Значения флага --storage.
+const (
+	storageDB     = "db"
+	storageMemory = "memory"
 )
 
 func main() {
-	dbConn := db.ConnectDB()
-	if err := dbConn.AutoMigrate(&calculationService.Calculation{}); err != nil {
-		log.Fatalf("failed to migrate database: %v", err)
+	storage := flag.String("storage", storageDB, `where tasks and users are kept: "db" (the DB_DRIVER database) or "memory" (lost on exit)`)
+	flag.Parse()
+
+	dbConfig := db.ConfigFromEnv()
+	switch *storage {
+	case storageDB:
+	case storageMemory:
+		// Задачи и пользователи живут в памяти, а остальные данные — в
+		// SQLite в памяти: всё пропадает при остановке.
+		dbConfig = db.Config{Driver: db.DriverSQLite, DSN: ":memory:"}
+	default:
+		log.Fatalf("invalid --storage %q: want %q or %q", *storage, storageDB, storageMemory)
+	}
+	dbConn, err := db.Connect(dbConfig)
+	if err != nil {
+		log.Fatalf("could not connect to database: %v", err)
+	}
+	log.Printf("connected to %s database", dbConfig.Driver)
+	migrator := newMigrator(dbConn, dbConfig.Driver)
+	if args := flag.Args(); len(args) > 0 && args[0] == "migrate" {
+		if *storage == storageMemory {
+			log.Fatalf("migrate works only with --storage=%s", storageDB)
+		}
+		os.Exit(runMigrate(migrator, args[1:]))
+	}
+
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatalf("JWT_SECRET must be set to sign access tokens")
+	}
+
+	migrateSchema(migrator)
+
+	variableRepo := variableService.NewVariableRepository(dbConn)
+	variableSvc := variableService.NewVariableService(variableRepo)
+	variableHandler := handlers.NewVariableHandler(variableSvc)
+
+	var (
+		repo     calculationService.CalculationRepository
+		userRepo userService.UserRepository
+	)
+	if *storage == storageMemory {
+		repo = calculationService.NewMemoryRepository()
+		userRepo = userService.NewMemoryUserRepository(repo)
+	} else {
+		repo = calculationService.NewCalculationRepository(dbConn)
+		userRepo = userService.NewUserRepository(dbConn)
 	}
 
-	repo := calculationService.NewCalculationRepository(dbConn)
-	service := calculationService.NewCalculationService(repo)
+	functionRepo := functionService.NewFunctionRepository(dbConn)
+	functionSvc := functionService.NewFunctionService(functionRepo, repo)
+	functionHandler := handlers.NewFunctionHandler(functionSvc)
+
+	// Курсы валют задаются относительно CURRENCY_BASE (по умолчанию USD).
+	rateRepo := currencyService.NewRateRepository(dbConn)
+	currencySvc := currencyService.NewCurrencyService(rateRepo, os.Getenv("CURRENCY_BASE"))
+	rateHandler := handlers.NewRateHandler(currencySvc)
+
+	service := calculationService.NewCalculationService(repo,
+		calculationService.WithVariableSource(variableSvc),
+		calculationService.WithFunctionSource(functionSvc),
+		calculationService.WithRateSource(currencySvc),
+		calculationService.WithLimits(evalLimits()),
+	)
 	handler := handlers.NewTaskHandler(service)
 
+	retention, interval := 30*24*time.Hour, time.Hour
+	envDuration("TRASH_RETENTION", &retention)
+	envDuration("TRASH_PURGE_INTERVAL", &interval)
+	if retention > 0 {
+		if interval <= 0 {
+			log.Fatalf("TRASH_PURGE_INTERVAL must be positive, got %s", interval)
+		}
+		go purgeTrash(service, retention, interval)
+	}
+
+	userSvc := userService.NewUserService(userRepo)
+	userHandler := handlers.NewUserHandler(userSvc)
+
+	tokenRepo := authService.NewRefreshTokenRepository(dbConn)
+	authSvc := authService.NewAuthService(authService.Config{
+		Secret:     []byte(jwtSecret),
+		Issuer:     "calculator-api",
+		AccessTTL:  15 * time.Minute,
+		RefreshTTL: 7 * 24 * time.Hour,
+	}, userSvc, tokenRepo)
+	authHandler := handlers.NewAuthHandler(authSvc)
+
 	e := echo.New()
+	e.HTTPErrorHandler = handlers.ErrorHandler
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
-	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"http://localhost:3000"},
-		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
-	}))
+	e.Use(middleware.CORSWithConfig(corsConfig()))
+	e.Use(handlers.JWTAuth(authSvc))
+	// Время на запрос к API вместе с запросами к БД; DB_TIMEOUT=0 отключает.
+	dbTimeout := 10 * time.Second
+	envDuration("DB_TIMEOUT", &dbTimeout)
+	e.Use(handlers.DBTimeout(dbTimeout))
+
+	validator, err := handlers.OpenAPIValidator(handlers.ValidatorConfig{
+		Specs:             openAPISpecs(),
+		ValidateResponses: os.Getenv("OPENAPI_VALIDATE_RESPONSES") == "true",
+	})
+	if err != nil {
+		log.Fatalf("failed to load OpenAPI spec: %v", err)
+	}
+	e.Use(validator)
 
 	strictHandler := tasks.NewStrictHandler(handler, nil)
 	tasks.RegisterHandlers(e, strictHandler)
 
+	strictUserHandler := users.NewStrictHandler(userHandler, nil)
+	users.RegisterHandlers(e, strictUserHandler)
+
+	strictAuthHandler := auth.NewStrictHandler(authHandler, nil)
+	auth.RegisterHandlers(e, strictAuthHandler)
+
+	strictVariableHandler := variables.NewStrictHandler(variableHandler, nil)
+	variables.RegisterHandlers(e, strictVariableHandler)
+
+	strictFunctionHandler := functions.NewStrictHandler(functionHandler, nil)
+	functions.RegisterHandlers(e, strictFunctionHandler)
+
+	strictRateHandler := rates.NewStrictHandler(rateHandler, nil)
+	rates.RegisterHandlers(e, strictRateHandler)
+
 	if err := e.Start(":8080"); err != nil {
 		log.Fatalf("failed to start with err: %v", err)
 	}
 }
+
+// openAPISpecs — спецификации всех сгенерированных пакетов API.
+func openAPISpecs() []*openapi3.T {
+	loaders := []func() (*openapi3.T, error){
+		tasks.GetSwagger,
+		users.GetSwagger,
+		auth.GetSwagger,
+		variables.GetSwagger,
+		functions.GetSwagger,
+		rates.GetSwagger,
+	}
+	specs := make([]*openapi3.T, 0, len(loaders))
+	for _, load := range loaders {
+		spec, err := load()
+		if err != nil {
+			log.Fatalf("failed to load OpenAPI spec: %v", err)
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// evalLimits — ограничения вычислений: значения по умолчанию, переопределяемые
+// переменными окружения CALC_MAX_LENGTH, CALC_MAX_TOKENS, CALC_MAX_DEPTH,
+// CALC_TIMEOUT (например, "500ms") и CALC_MAX_RESULT_DIGITS.
+func evalLimits() calculationService.Limits {
+	limits := calculationService.DefaultLimits()
+	envInt("CALC_MAX_LENGTH", &limits.MaxLength)
+	envInt("CALC_MAX_TOKENS", &limits.MaxTokens)
+	envInt("CALC_MAX_DEPTH", &limits.MaxDepth)
+	envInt("CALC_MAX_RESULT_DIGITS", &limits.MaxResultDigits)
+	envDuration("CALC_TIMEOUT", &limits.Timeout)
+	return limits
+}
+
+// purgeTrash — сразу и затем раз в interval окончательно удаляет задачи,
+// пролежавшие в корзине дольше retention (TRASH_RETENTION, по умолчанию
+// 30 дней; 0 отключает очистку). Одна очистка длится не дольше interval.
+func purgeTrash(service calculationService.CalculationService, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		n, err := service.PurgeTrash(ctx, retention)
+		cancel()
+		if err != nil {
+			log.Printf("failed to purge trash: %v", err)
+		} else if n > 0 {
+			log.Printf("purged %d tasks deleted more than %s ago", n, retention)
+		}
+		<-ticker.C
+	}
+}
+
+// envDuration — читает длительность (например, "500ms" или "720h") из
+// переменной окружения, если она задана.
+func envDuration(key string, dst *time.Duration) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("invalid %s %q: %v", key, value, err)
+	}
+	*dst = d
+}
+
+// envInt — читает целое из переменной окружения, если она задана.
+func envInt(key string, dst *int) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("invalid %s %q: %v", key, value, err)
+	}
+	*dst = n
+}
+
+// corsConfig — CORS для фронтенда на localhost:3000. If-Match и ETag нужны
+// условным PATCH и DELETE: без них браузер не отправит версию задачи и не
+// покажет её в ответе.
+func corsConfig() middleware.CORSConfig {
+	return middleware.CORSConfig{
+		AllowOrigins:  []string{"http://localhost:3000"},
+		AllowMethods:  []string{echo.GET, echo.POST, echo.PUT, echo.PATCH, echo.DELETE},
+		AllowHeaders:  []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "If-Match"},
+		ExposeHeaders: []string{"ETag"},
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSConditionalRequests(t *testing.T) {
+	e := echo.New()
+	e.Use(middleware.CORSWithConfig(corsConfig()))
+	e.GET("/tasks/1", func(c echo.Context) error {
+		c.Response().Header().Set("ETag", `"3"`)
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodOptions, "/tasks/1", nil)
+	req.Header.Set(echo.HeaderOrigin, "http://localhost:3000")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPatch)
+	req.Header.Set(echo.HeaderAccessControlRequestHeaders, "content-type, if-match")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "http://localhost:3000", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	assert.Contains(t, rec.Header().Get(echo.HeaderAccessControlAllowHeaders), "If-Match")
+
+	req = httptest.NewRequest(http.MethodGet, "/tasks/1", nil)
+	req.Header.Set(echo.HeaderOrigin, "http://localhost:3000")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "ETag", rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"gorm.io/gorm"
+
+	"CalculatorAppFrontendPantela-main/internal/db"
+)
+
+const migrateUsage = `usage: main migrate <command>
+
+commands:
+  up             apply all pending migrations
+  down [N]       revert the last N applied migrations (default 1)
+  status         show the schema version and every known migration
+  force VERSION  record VERSION as the schema version without running
+                 anything and clear the dirty flag (0 — no migrations)`
+
+// newMigrator — мигратор с миграциями для СУБД driver, встроенными в бинарник.
+func newMigrator(conn *gorm.DB, driver string) *db.Migrator {
+	dir, err := db.Migrations(driver)
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+	migrator, err := db.NewMigrator(conn, dir)
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+	return migrator
+}
+
+// migrateSchema — при старте применяет недостающие миграции (если
+// MIGRATE_ON_START не "false") и отказывается работать со схемой, версия
+// которой не последняя из известных бинарнику.
+func migrateSchema(migrator *db.Migrator) {
+	if os.Getenv("MIGRATE_ON_START") != "false" {
+		n, err := migrator.Up()
+		if err != nil {
+			log.Fatalf("failed to migrate database: %v", err)
+		}
+		if n > 0 {
+			log.Printf("applied %d migration(s)", n)
+		}
+	}
+	if err := migrator.Check(); err != nil {
+		log.Fatalf("refusing to start: %v", err)
+	}
+}
+
+// runMigrate — подкоманда "migrate": up, down [N], status, force VERSION.
+// Возвращает код завершения процесса.
+func runMigrate(migrator *db.Migrator, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	switch cmd, rest := args[0], args[1:]; {
+	case cmd == "up" && len(rest) == 0:
+		n, err := migrator.Up()
+		if err != nil {
+			log.Printf("applied %d migration(s), then failed: %v", n, err)
+			return 1
+		}
+		fmt.Printf("applied %d migration(s)\n", n)
+
+	case cmd == "down" && len(rest) <= 1:
+		steps := 1
+		if len(rest) == 1 {
+			var err error
+			if steps, err = strconv.Atoi(rest[0]); err != nil || steps < 1 {
+				fmt.Fprintf(os.Stderr, "invalid number of migrations %q\n", rest[0])
+				return 2
+			}
+		}
+		n, err := migrator.Down(steps)
+		if err != nil {
+			log.Printf("reverted %d migration(s), then failed: %v", n, err)
+			return 1
+		}
+		fmt.Printf("reverted %d migration(s)\n", n)
+
+	case cmd == "status" && len(rest) == 0:
+		version, dirty, all, err := migrator.Status()
+		if err != nil {
+			log.Printf("failed to read schema version: %v", err)
+			return 1
+		}
+		state := ""
+		if dirty {
+			state = " (dirty)"
+		}
+		fmt.Printf("schema version: %d%s\n", version, state)
+		for _, m := range all {
+			mark := "pending"
+			if m.Applied {
+				mark = "applied"
+			}
+			fmt.Printf("  %-7s  %d_%s\n", mark, m.Version, m.Name)
+		}
+		if err := migrator.Check(); err != nil {
+			fmt.Println(err)
+		}
+
+	case cmd == "force" && len(rest) == 1:
+		version, err := strconv.ParseInt(rest[0], 10, 64)
+		if err != nil || version < 0 {
+			fmt.Fprintf(os.Stderr, "invalid version %q\n", rest[0])
+			return 2
+		}
+		if err := migrator.Force(version); err != nil {
+			log.Printf("failed to force version: %v", err)
+			return 1
+		}
+		fmt.Printf("schema version forced to %d\n", version)
+
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+	return 0
+}
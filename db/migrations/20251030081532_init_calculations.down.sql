@@ -1 +0,0 @@
-DROP TABLE IF EXISTS calculations;
\ No newline at end of file
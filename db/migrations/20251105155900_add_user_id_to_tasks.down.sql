@@ -1 +0,0 @@
-ALTER TABLE tasks DROP COLUMN user_id;
@@ -0,0 +1,14 @@
+// Package migrations — SQL-миграции схемы БД, встроенные в бинарник.
+//
+// Миграции лежат в каталоге СУБД (postgres/ и sqlite/) парами
+// <версия>_<имя>.up.sql и <версия>_<имя>.down.sql; версия — время создания,
+// YYYYMMDDhhmmss. Каждая миграция есть в обоих каталогах с одной версией и
+// именем. Применённые миграции меняются только новыми миграциями.
+package migrations
+
+import "embed"
+
+// FS — каталоги миграций по СУБД: postgres/ и sqlite/.
+//
+//go:embed postgres/*.sql sqlite/*.sql
+var FS embed.FS
@@ -0,0 +1,92 @@
+-- Базовая схема: все таблицы моделей. Таблицы и индексы создаются, только
+-- если их ещё нет: таблицы, созданные до встроенных миграций (golang-migrate
+-- или AutoMigrate), остаются как были, и их приводит к этой схеме миграция
+-- 20261018170000_legacy_schema.
+
+CREATE TABLE IF NOT EXISTS calculations (
+    id           text PRIMARY KEY,
+    expression   varchar(255) NOT NULL,
+    result       text,
+    engine       text,
+    mode         text,
+    precision    bigint,
+    angle_unit   text,
+    user_id      text,
+    updated_by   text,
+    variables    text,
+    functions    text,
+    result_value decimal,
+    version      bigint NOT NULL DEFAULT 1,
+    created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at   timestamptz
+);
+-- Колонки для индексов ниже, которых нет в прежних таблицах calculations.
+ALTER TABLE calculations
+    ADD COLUMN IF NOT EXISTS user_id      text,
+    ADD COLUMN IF NOT EXISTS result_value decimal,
+    ADD COLUMN IF NOT EXISTS created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deleted_at   timestamptz;
+CREATE INDEX IF NOT EXISTS idx_calculations_user_id ON calculations (user_id);
+CREATE INDEX IF NOT EXISTS idx_calculations_result_value ON calculations (result_value);
+CREATE INDEX IF NOT EXISTS idx_calculations_created_at ON calculations (created_at);
+CREATE INDEX IF NOT EXISTS idx_calculations_deleted_at ON calculations (deleted_at);
+
+CREATE TABLE IF NOT EXISTS revisions (
+    id             bigserial PRIMARY KEY,
+    calculation_id text NOT NULL,
+    number         bigint NOT NULL,
+    expression     varchar(255) NOT NULL,
+    result         text,
+    engine         text,
+    mode           text,
+    precision      bigint,
+    angle_unit     text,
+    variables      text,
+    functions      text,
+    author_id      text,
+    created_at     timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_revisions_calculation_number ON revisions (calculation_id, number);
+
+CREATE TABLE IF NOT EXISTS users (
+    id         text PRIMARY KEY,
+    email      text NOT NULL CONSTRAINT uni_users_email UNIQUE,
+    password   text NOT NULL,
+    is_admin   boolean NOT NULL DEFAULT false,
+    deleted_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id         text PRIMARY KEY,
+    user_id    text NOT NULL,
+    expires_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS variables (
+    id          text PRIMARY KEY,
+    user_id     text NOT NULL,
+    name        varchar(64) NOT NULL,
+    value       text NOT NULL,
+    description text,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_variables_user_name ON variables (user_id, name);
+
+CREATE TABLE IF NOT EXISTS user_functions (
+    id          text PRIMARY KEY,
+    user_id     text NOT NULL,
+    name        varchar(64) NOT NULL,
+    params      text NOT NULL,
+    body        text NOT NULL,
+    description text,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_functions_user_name ON user_functions (user_id, name);
@@ -0,0 +1,2 @@
+ALTER TABLE revisions DROP COLUMN unit;
+ALTER TABLE calculations DROP COLUMN unit;
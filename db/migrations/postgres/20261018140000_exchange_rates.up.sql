@@ -0,0 +1,13 @@
+-- Курсы валют для режима units и снимок использованных курсов в записях.
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id             text PRIMARY KEY,
+    currency       varchar(3) NOT NULL,
+    effective_date varchar(10) NOT NULL,
+    rate           text NOT NULL,
+    created_at     timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exchange_rates_currency_date ON exchange_rates (currency, effective_date);
+
+ALTER TABLE calculations ADD COLUMN rates text;
+ALTER TABLE revisions ADD COLUMN rates text;
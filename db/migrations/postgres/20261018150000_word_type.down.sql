@@ -0,0 +1,2 @@
+ALTER TABLE revisions DROP COLUMN word_type;
+ALTER TABLE calculations DROP COLUMN word_type;
@@ -0,0 +1,4 @@
+-- Тип слова режима programmer ("uint8"); у остальных режимов пустой.
+
+ALTER TABLE calculations ADD COLUMN word_type text;
+ALTER TABLE revisions ADD COLUMN word_type text;
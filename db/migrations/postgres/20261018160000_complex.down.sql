@@ -0,0 +1,4 @@
+ALTER TABLE revisions DROP COLUMN complex_form;
+ALTER TABLE revisions DROP COLUMN result_type;
+ALTER TABLE calculations DROP COLUMN complex_form;
+ALTER TABLE calculations DROP COLUMN result_type;
@@ -0,0 +1,10 @@
+-- Тип результата ("number", "complex") и запись комплексного результата
+-- режима complex ("rectangular", "polar"). Все прежние результаты — числа.
+
+ALTER TABLE calculations ADD COLUMN result_type text;
+ALTER TABLE calculations ADD COLUMN complex_form text;
+ALTER TABLE revisions ADD COLUMN result_type text;
+ALTER TABLE revisions ADD COLUMN complex_form text;
+
+UPDATE calculations SET result_type = 'number';
+UPDATE revisions SET result_type = 'number';
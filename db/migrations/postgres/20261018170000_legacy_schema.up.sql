@@ -0,0 +1,42 @@
+-- Приводит к базовой схеме таблицы, созданные до встроенных миграций:
+-- базовая миграция их не пересоздаёт. В новой БД ничего не меняет.
+--
+-- calculations прежних версий:
+--   golang-migrate (init_calculations): id SERIAL, expression, result FLOAT
+--   NOT NULL, created_at TIMESTAMP;
+--   AutoMigrate: id, expression, result и user_id (text) плюс колонки,
+--   добавленные до последнего запуска AutoMigrate.
+-- В users, созданной AutoMigrate до появления администраторов, нет is_admin.
+
+ALTER TABLE calculations
+    ADD COLUMN IF NOT EXISTS result       text,
+    ADD COLUMN IF NOT EXISTS engine       text,
+    ADD COLUMN IF NOT EXISTS mode         text,
+    ADD COLUMN IF NOT EXISTS precision    bigint,
+    ADD COLUMN IF NOT EXISTS angle_unit   text,
+    ADD COLUMN IF NOT EXISTS user_id      text,
+    ADD COLUMN IF NOT EXISTS updated_by   text,
+    ADD COLUMN IF NOT EXISTS variables    text,
+    ADD COLUMN IF NOT EXISTS functions    text,
+    ADD COLUMN IF NOT EXISTS result_value decimal,
+    ADD COLUMN IF NOT EXISTS version      bigint NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS created_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS updated_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deleted_at   timestamptz;
+
+-- Типы и ограничения прежних колонок; для базовой схемы это пустые изменения.
+-- Выражение длиннее 255 символов (до ограничений длины) остановит миграцию:
+-- его нужно сократить или удалить вручную.
+ALTER TABLE calculations ALTER COLUMN expression TYPE varchar(255);
+ALTER TABLE calculations ALTER COLUMN expression SET NOT NULL;
+ALTER TABLE calculations ALTER COLUMN id DROP DEFAULT;
+DROP SEQUENCE IF EXISTS calculations_id_seq;
+ALTER TABLE calculations ALTER COLUMN id TYPE text USING id::text;
+ALTER TABLE calculations ALTER COLUMN result TYPE text USING result::text;
+ALTER TABLE calculations ALTER COLUMN result DROP NOT NULL;
+UPDATE calculations SET created_at = CURRENT_TIMESTAMP WHERE created_at IS NULL;
+ALTER TABLE calculations ALTER COLUMN created_at TYPE timestamptz;
+ALTER TABLE calculations ALTER COLUMN created_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE calculations ALTER COLUMN created_at SET NOT NULL;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin boolean NOT NULL DEFAULT false;
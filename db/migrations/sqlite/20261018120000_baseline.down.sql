@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS user_functions;
+DROP TABLE IF EXISTS variables;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS revisions;
+DROP TABLE IF EXISTS calculations;
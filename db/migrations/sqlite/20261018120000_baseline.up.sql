@@ -0,0 +1,84 @@
+-- Базовая схема для SQLite: те же таблицы, что и для PostgreSQL, с типами,
+-- которые понимает SQLite.
+
+CREATE TABLE IF NOT EXISTS calculations (
+    id           text NOT NULL PRIMARY KEY,
+    expression   varchar(255) NOT NULL,
+    result       text,
+    engine       text,
+    mode         text,
+    precision    integer,
+    angle_unit   text,
+    user_id      text,
+    updated_by   text,
+    variables    text,
+    functions    text,
+    result_value real,
+    version      integer NOT NULL DEFAULT 1,
+    created_at   datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at   datetime
+);
+CREATE INDEX IF NOT EXISTS idx_calculations_user_id ON calculations (user_id);
+CREATE INDEX IF NOT EXISTS idx_calculations_result_value ON calculations (result_value);
+CREATE INDEX IF NOT EXISTS idx_calculations_created_at ON calculations (created_at);
+CREATE INDEX IF NOT EXISTS idx_calculations_deleted_at ON calculations (deleted_at);
+
+CREATE TABLE IF NOT EXISTS revisions (
+    id             integer PRIMARY KEY AUTOINCREMENT,
+    calculation_id text NOT NULL,
+    number         integer NOT NULL,
+    expression     varchar(255) NOT NULL,
+    result         text,
+    engine         text,
+    mode           text,
+    precision      integer,
+    angle_unit     text,
+    variables      text,
+    functions      text,
+    author_id      text,
+    created_at     datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_revisions_calculation_number ON revisions (calculation_id, number);
+
+CREATE TABLE IF NOT EXISTS users (
+    id         text NOT NULL PRIMARY KEY,
+    email      text NOT NULL CONSTRAINT uni_users_email UNIQUE,
+    password   text NOT NULL,
+    is_admin   boolean NOT NULL DEFAULT false,
+    deleted_at datetime,
+    created_at datetime,
+    updated_at datetime
+);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id         text NOT NULL PRIMARY KEY,
+    user_id    text NOT NULL,
+    expires_at datetime,
+    revoked_at datetime,
+    created_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS variables (
+    id          text NOT NULL PRIMARY KEY,
+    user_id     text NOT NULL,
+    name        varchar(64) NOT NULL,
+    value       text NOT NULL,
+    description text,
+    created_at  datetime,
+    updated_at  datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_variables_user_name ON variables (user_id, name);
+
+CREATE TABLE IF NOT EXISTS user_functions (
+    id          text NOT NULL PRIMARY KEY,
+    user_id     text NOT NULL,
+    name        varchar(64) NOT NULL,
+    params      text NOT NULL,
+    body        text NOT NULL,
+    description text,
+    created_at  datetime,
+    updated_at  datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_functions_user_name ON user_functions (user_id, name);
@@ -0,0 +1,4 @@
+-- Единица результата режима units ("km/h"); у остальных режимов пустая.
+
+ALTER TABLE calculations ADD COLUMN unit text;
+ALTER TABLE revisions ADD COLUMN unit text;
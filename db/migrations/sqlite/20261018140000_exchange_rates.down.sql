@@ -0,0 +1,4 @@
+ALTER TABLE revisions DROP COLUMN rates;
+ALTER TABLE calculations DROP COLUMN rates;
+
+DROP TABLE IF EXISTS exchange_rates;
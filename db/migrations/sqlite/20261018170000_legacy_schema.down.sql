@@ -0,0 +1 @@
+-- Откат ничего не меняет: прежнюю схему не восстановить, а базовой она не мешает.
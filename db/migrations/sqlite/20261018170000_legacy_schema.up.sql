@@ -0,0 +1,2 @@
+-- Пара миграции PostgreSQL с тем же именем. Таблиц SQLite, созданных до
+-- встроенных миграций, не бывает: SQLite поддерживается только с ними.
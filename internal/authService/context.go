@@ -0,0 +1,23 @@
+package authService
+
+import "context"
+
+// Identity — аутентифицированный пользователь текущего запроса.
+type Identity struct {
+	UserID  string
+	Email   string
+	IsAdmin bool
+}
+
+type identityKey struct{}
+
+// WithIdentity — возвращает контекст, в котором сохранён пользователь запроса.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext — достаёт пользователя, положенного middleware аутентификации.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
@@ -0,0 +1,26 @@
+package authService
+
+import "time"
+
+// RefreshToken — выданный refresh-токен. Хранится только его ID (jti):
+// по нему токен можно отозвать при logout или ротации.
+type RefreshToken struct {
+	ID        string     `gorm:"primaryKey" json:"id"`          // jti из подписанного токена
+	UserID    string     `gorm:"index;not null" json:"user_id"` // владелец токена
+	ExpiresAt time.Time  `json:"expires_at"`                    // срок действия
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`          // когда токен отозван (nil — активен)
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TokenPair — пара токенов, которую получает клиент после входа.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration // время жизни access-токена
+}
+
+// LoginRequest — структура для входа по email и паролю.
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
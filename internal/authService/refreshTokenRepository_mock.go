@@ -0,0 +1,28 @@
+package authService
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRefreshTokenRepository — поддельный репозиторий refresh-токенов
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) CreateRefreshToken(ctx context.Context, token RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) GetRefreshToken(ctx context.Context, id string) (RefreshToken, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) RevokeRefreshToken(ctx context.Context, id string, at time.Time) (bool, error) {
+	args := m.Called(ctx, id, at)
+	return args.Bool(0), args.Error(1)
+}
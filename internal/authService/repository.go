@@ -0,0 +1,44 @@
+package authService
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RefreshTokenRepository — интерфейс для хранения выданных refresh-токенов
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token RefreshToken) error
+	GetRefreshToken(ctx context.Context, id string) (RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, id string, at time.Time) (bool, error)
+}
+
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository — конструктор репозитория
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) CreateRefreshToken(ctx context.Context, token RefreshToken) error {
+	return r.db.WithContext(ctx).Create(&token).Error
+}
+
+func (r *refreshTokenRepository) GetRefreshToken(ctx context.Context, id string) (RefreshToken, error) {
+	var token RefreshToken
+	err := r.db.WithContext(ctx).First(&token, "id = ?", id).Error
+	return token, err
+}
+
+// RevokeRefreshToken — помечает токен отозванным. false — токен уже был
+// отозван (или его нет), и этот вызов ничего не изменил; из двух
+// одновременных отзывов true получает только один.
+func (r *refreshTokenRepository) RevokeRefreshToken(ctx context.Context, id string, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	return result.RowsAffected == 1, result.Error
+}
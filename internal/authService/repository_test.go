@@ -0,0 +1,45 @@
+package authService
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"CalculatorAppFrontendPantela-main/internal/db/dbtest"
+)
+
+// TestRevokeRefreshTokenOnce — из нескольких одновременных отзывов токена
+// успешен ровно один: так Refresh выдаёт по токену не больше одной пары.
+func TestRevokeRefreshTokenOnce(t *testing.T) {
+	repo := NewRefreshTokenRepository(dbtest.New(t))
+	now := time.Now().UTC()
+	require.NoError(t, repo.CreateRefreshToken(t.Context(), RefreshToken{ID: "jti", UserID: "alice", ExpiresAt: now.Add(time.Hour), CreatedAt: now}))
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		revoked int
+	)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := repo.RevokeRefreshToken(t.Context(), "jti", now)
+			assert.NoError(t, err)
+			if ok {
+				mu.Lock()
+				revoked++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, revoked)
+
+	ok, err := repo.RevokeRefreshToken(t.Context(), "unknown", now)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,206 @@
+package authService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	userService "CalculatorAppFrontendPantela-main/internal/userService"
+)
+
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+// ErrInvalidToken — токен не подписан нами, просрочен, отозван или не того типа.
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// Config — параметры подписи и времени жизни токенов.
+type Config struct {
+	Secret     []byte        // ключ HMAC для подписи (HS256)
+	Issuer     string        // значение iss в выдаваемых токенах
+	AccessTTL  time.Duration // время жизни access-токена
+	RefreshTTL time.Duration // время жизни refresh-токена
+}
+
+// UserAuthenticator — то, что сервису нужно от пользователей.
+// Реализуется userService.UserService.
+type UserAuthenticator interface {
+	Authenticate(ctx context.Context, email, password string) (userService.User, error)
+	GetUserByID(ctx context.Context, id string) (userService.User, error)
+}
+
+// AuthService — интерфейс входа, обновления токенов и их проверки.
+type AuthService interface {
+	Login(ctx context.Context, email, password string) (TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	ParseAccessToken(accessToken string) (Identity, error)
+}
+
+type authService struct {
+	cfg   Config
+	users UserAuthenticator
+	repo  RefreshTokenRepository
+	now   func() time.Time
+}
+
+// tokenClaims — содержимое наших JWT.
+type tokenClaims struct {
+	Email string `json:"email,omitempty"`
+	Admin bool   `json:"adm,omitempty"`
+	Type  string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+// NewAuthService — конструктор сервиса аутентификации
+func NewAuthService(cfg Config, users UserAuthenticator, repo RefreshTokenRepository) AuthService {
+	return &authService{cfg: cfg, users: users, repo: repo, now: time.Now}
+}
+
+// Login — проверяет email и пароль и выдаёт новую пару токенов.
+func (s *authService) Login(ctx context.Context, email, password string) (TokenPair, error) {
+	user, err := s.users.Authenticate(ctx, email, password)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	return s.issue(ctx, user)
+}
+
+// Refresh — обменивает действующий refresh-токен на новую пару.
+// Старый refresh-токен при этом отзывается (ротация).
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
+	claims, err := s.parse(refreshToken, tokenTypeRefresh)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	stored, err := s.repo.GetRefreshToken(ctx, claims.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return TokenPair{}, ErrInvalidToken
+	}
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if stored.RevokedAt != nil || stored.UserID != claims.Subject {
+		return TokenPair{}, ErrInvalidToken
+	}
+
+	user, err := s.users.GetUserByID(ctx, claims.Subject)
+	if errors.Is(err, userService.ErrUserNotFound) {
+		return TokenPair{}, ErrInvalidToken
+	}
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	// Токен меняется на новую пару только один раз: если его уже отозвал
+	// параллельный Refresh или Logout, новой пары нет.
+	revoked, err := s.repo.RevokeRefreshToken(ctx, stored.ID, s.now())
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if !revoked {
+		return TokenPair{}, ErrInvalidToken
+	}
+
+	return s.issue(ctx, user)
+}
+
+// Logout — отзывает refresh-токен. Access-токен доживает свой короткий срок.
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	claims, err := s.parse(refreshToken, tokenTypeRefresh)
+	if err != nil {
+		return err
+	}
+	// Уже отозванный токен — не ошибка: выход повторяем.
+	_, err = s.repo.RevokeRefreshToken(ctx, claims.ID, s.now())
+	return err
+}
+
+// ParseAccessToken — проверяет access-токен и возвращает пользователя из него.
+func (s *authService) ParseAccessToken(accessToken string) (Identity, error) {
+	claims, err := s.parse(accessToken, tokenTypeAccess)
+	if err != nil {
+		return Identity{}, err
+	}
+	return Identity{UserID: claims.Subject, Email: claims.Email, IsAdmin: claims.Admin}, nil
+}
+
+// issue — подписывает access- и refresh-токены и запоминает refresh-токен.
+func (s *authService) issue(ctx context.Context, user userService.User) (TokenPair, error) {
+	now := s.now()
+
+	access, err := s.sign(tokenClaims{
+		Email: user.Email,
+		Admin: user.IsAdmin,
+		Type:  tokenTypeAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   user.ID,
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.AccessTTL)),
+		},
+	})
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	stored := RefreshToken{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		ExpiresAt: now.Add(s.cfg.RefreshTTL),
+		CreatedAt: now,
+	}
+	refresh, err := s.sign(tokenClaims{
+		Type: tokenTypeRefresh,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        stored.ID,
+			Subject:   user.ID,
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(stored.ExpiresAt),
+		},
+	})
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	if err := s.repo.CreateRefreshToken(ctx, stored); err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{AccessToken: access, RefreshToken: refresh, ExpiresIn: s.cfg.AccessTTL}, nil
+}
+
+func (s *authService) sign(claims tokenClaims) (string, error) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.cfg.Secret)
+	if err != nil {
+		return "", fmt.Errorf("sign token: %w", err)
+	}
+	return token, nil
+}
+
+// parse — проверяет подпись, срок, издателя и тип токена.
+func (s *authService) parse(raw, wantType string) (*tokenClaims, error) {
+	claims := &tokenClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(*jwt.Token) (interface{}, error) {
+		return s.cfg.Secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.cfg.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil || claims.Type != wantType || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
@@ -0,0 +1,150 @@
+package authService
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	userService "CalculatorAppFrontendPantela-main/internal/userService"
+)
+
+// stubUsers — заглушка пользователей с одним зарегистрированным пользователем
+type stubUsers struct {
+	user userService.User
+}
+
+func (s stubUsers) Authenticate(_ context.Context, email, password string) (userService.User, error) {
+	if email != s.user.Email || password != "secret" {
+		return userService.User{}, userService.ErrInvalidCredentials
+	}
+	return s.user, nil
+}
+
+func (s stubUsers) GetUserByID(_ context.Context, id string) (userService.User, error) {
+	if id != s.user.ID {
+		return userService.User{}, userService.ErrUserNotFound
+	}
+	return s.user, nil
+}
+
+var testUser = userService.User{ID: "user-1", Email: "a@example.com"}
+
+func newTestService(repo RefreshTokenRepository, now time.Time) *authService {
+	svc := NewAuthService(Config{
+		Secret:     []byte("test-secret"),
+		Issuer:     "test",
+		AccessTTL:  time.Minute,
+		RefreshTTL: time.Hour,
+	}, stubUsers{user: testUser}, repo).(*authService)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{name: "успешный вход", password: "secret"},
+		{name: "неверный пароль", password: "wrong", wantErr: userService.ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockRefreshTokenRepository)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateRefreshToken", mock.Anything, mock.MatchedBy(func(rt RefreshToken) bool {
+					return rt.UserID == testUser.ID && rt.ID != ""
+				})).Return(nil)
+			}
+
+			svc := newTestService(mockRepo, time.Now())
+			pair, err := svc.Login(t.Context(), testUser.Email, tt.password)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				identity, err := svc.ParseAccessToken(pair.AccessToken)
+				require.NoError(t, err)
+				assert.Equal(t, Identity{UserID: testUser.ID, Email: testUser.Email}, identity)
+
+				// refresh-токен нельзя использовать как access-токен
+				_, err = svc.ParseAccessToken(pair.RefreshToken)
+				assert.ErrorIs(t, err, ErrInvalidToken)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParseAccessTokenExpired(t *testing.T) {
+	mockRepo := new(MockRefreshTokenRepository)
+	mockRepo.On("CreateRefreshToken", mock.Anything, mock.Anything).Return(nil)
+
+	issuedAt := time.Now()
+	pair, err := newTestService(mockRepo, issuedAt).Login(t.Context(), testUser.Email, "secret")
+	require.NoError(t, err)
+
+	_, err = newTestService(mockRepo, issuedAt.Add(2*time.Minute)).ParseAccessToken(pair.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestRefresh(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		stored  RefreshToken
+		findErr error
+		// revoked — отозвал ли токен сам Refresh; false — его опередил
+		// параллельный запрос с тем же токеном.
+		revoked bool
+		wantErr error
+	}{
+		{name: "ротация действующего токена", stored: RefreshToken{UserID: testUser.ID}, revoked: true},
+		{name: "токен уже обменял параллельный запрос", stored: RefreshToken{UserID: testUser.ID}, revoked: false, wantErr: ErrInvalidToken},
+		{name: "отозванный токен", stored: RefreshToken{UserID: testUser.ID, RevokedAt: &revokedAt}, wantErr: ErrInvalidToken},
+		{name: "неизвестный токен", findErr: gorm.ErrRecordNotFound, wantErr: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockRefreshTokenRepository)
+			var issued RefreshToken
+			mockRepo.On("CreateRefreshToken", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				issued = args.Get(1).(RefreshToken)
+			}).Return(nil)
+
+			svc := newTestService(mockRepo, now)
+			pair, err := svc.Login(t.Context(), testUser.Email, "secret")
+			require.NoError(t, err)
+
+			stored := tt.stored
+			stored.ID = issued.ID
+			mockRepo.On("GetRefreshToken", mock.Anything, issued.ID).Return(stored, tt.findErr)
+			if tt.findErr == nil && tt.stored.RevokedAt == nil {
+				mockRepo.On("RevokeRefreshToken", mock.Anything, issued.ID, now).Return(tt.revoked, nil)
+			}
+
+			_, err = svc.Refresh(t.Context(), pair.RefreshToken)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNumberOfCalls(t, "CreateRefreshToken", 1)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
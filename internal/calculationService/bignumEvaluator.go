@@ -0,0 +1,436 @@
+package calculationService
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// BignumEngine — имя движка произвольной точности.
+const BignumEngine = "bignum"
+
+// bignumEvaluator — движок на math/big: точные дроби (ModeRational)
+// и десятичные числа с заданным числом значащих цифр (ModeDecimal).
+type bignumEvaluator struct{}
+
+// astProgram — выражение, разобранное собственным парсером.
+type astProgram struct {
+	source string
+	root   node
+}
+
+func (p *astProgram) Source() string { return p.source }
+
+func (p *astProgram) Variables() []string { return identifiers(p.root) }
+
+// NewBignumEvaluator — создаёт движок произвольной точности.
+func NewBignumEvaluator() Evaluator {
+	return bignumEvaluator{}
+}
+
+func (bignumEvaluator) Name() string { return BignumEngine }
+
+func (bignumEvaluator) Capabilities() Capabilities {
+	return Capabilities{
+		Description: "Arbitrary-precision arithmetic: exact rationals or configurable-precision decimals (math/big)",
+		Modes:       []string{ModeRational, ModeDecimal},
+		Variables:   true,
+		Functions:   true,
+	}
+}
+
+func (bignumEvaluator) Parse(expression string, env Env) (Program, error) {
+	root, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if err := env.Limits.checkTree(expression, root); err != nil {
+		return nil, err
+	}
+	return &astProgram{source: expression, root: root}, nil
+}
+
+func (bignumEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	p, ok := program.(*astProgram)
+	if !ok {
+		return Evaluation{}, fmt.Errorf("bignum: foreign program %T", program)
+	}
+	env.ctx = ctx
+
+	switch env.Mode {
+	case ModeRational:
+		r, err := evalRat(p.root, env)
+		if err != nil {
+			return Evaluation{}, err
+		}
+		return Evaluation{Result: formatRat(r)}, nil
+	case ModeDecimal:
+		f, err := evalDecimal(p.root, decimalBits(env.Precision), env)
+		if err != nil {
+			return Evaluation{}, err
+		}
+		return Evaluation{Result: formatDecimal(f, env.Precision)}, nil
+	default:
+		return Evaluation{}, fmt.Errorf("%w: %q", ErrUnsupportedMode, env.Mode)
+	}
+}
+
+// evalRat — вычисляет дерево в точных дробях с переменными и функциями из env.
+func evalRat(n node, env Env) (*big.Rat, error) {
+	if err := env.interrupted(); err != nil {
+		return nil, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		r, ok := new(big.Rat).SetString(n.text)
+		if !ok {
+			return nil, &SyntaxError{Offset: n.pos, Token: n.text, Message: "malformed number"}
+		}
+		return r, nil
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			return nil, unsupportedNode(n)
+		}
+		r, ok := new(big.Rat).SetString(value)
+		if !ok {
+			return nil, fmt.Errorf("variable %q has malformed value %q", n.name, value)
+		}
+		return r, nil
+	case *callNode:
+		f, ok := env.Functions[n.name]
+		if !ok {
+			return nil, unsupportedNode(n)
+		}
+		args := make([]*big.Rat, len(n.args))
+		for i, arg := range n.args {
+			x, err := evalRat(arg, env)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = x
+		}
+		return f.callExact(args)
+	case *unaryNode:
+		x, err := evalRat(n.x, env)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "-" {
+			x.Neg(x)
+		}
+		return x, nil
+	case *binaryNode:
+		x, err := evalRat(n.x, env)
+		if err != nil {
+			return nil, err
+		}
+		y, err := evalRat(n.y, env)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "+":
+			return x.Add(x, y), nil
+		case "-":
+			return x.Sub(x, y), nil
+		case "*":
+			return x.Mul(x, y), nil
+		case "/":
+			if y.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			return x.Quo(x, y), nil
+		case "%":
+			if y.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			// x mod y = x - y*floor(x/y)
+			q := new(big.Rat).Quo(x, y)
+			floor := new(big.Int).Div(q.Num(), q.Denom())
+			return x.Sub(x, y.Mul(y, new(big.Rat).SetInt(floor))), nil
+		case "^":
+			return powRat(x, y, n.pos, env.Limits)
+		}
+	}
+	return nil, unsupportedNode(n)
+}
+
+// powRat — возведение дроби в целую степень. Дробная степень в общем
+// случае иррациональна, поэтому в точном режиме не поддерживается.
+// Размер результата оценивается до возведения: 2^10000000 отклоняется
+// сразу, а не после минут работы.
+func powRat(x, y *big.Rat, pos int, limits Limits) (*big.Rat, error) {
+	if !y.IsInt() {
+		return nil, &SyntaxError{Offset: pos, Token: "^", Message: "rational mode supports only integer exponents"}
+	}
+	k := y.Num()
+	if !k.IsInt64() {
+		// Точны при любом показателе только степени 0 и ±1; у остальных
+		// числитель или знаменатель длиннее любого ограничения числа цифр.
+		switch {
+		case x.Sign() == 0 && k.Sign() < 0:
+			return nil, ErrDivisionByZero
+		case x.Sign() == 0:
+			return new(big.Rat), nil
+		case x.IsInt() && x.Num().CmpAbs(big.NewInt(1)) == 0:
+			if x.Sign() < 0 && k.Bit(0) == 1 {
+				return big.NewRat(-1, 1), nil
+			}
+			return big.NewRat(1, 1), nil
+		}
+		return nil, errExponentTooLarge(y.RatString())
+	}
+	if x.Sign() == 0 && k.Sign() < 0 {
+		return nil, ErrDivisionByZero
+	}
+	abs := new(big.Int).Abs(k)
+	times, _ := new(big.Float).SetInt(abs).Float64()
+	for _, part := range []*big.Int{x.Num(), x.Denom()} {
+		if err := limits.checkDigits(bitsToDigits(float64(part.BitLen()-1) * times)); err != nil {
+			return nil, err
+		}
+	}
+	num := new(big.Int).Exp(x.Num(), abs, nil)
+	den := new(big.Int).Exp(x.Denom(), abs, nil)
+	if k.Sign() < 0 {
+		num, den = den, num
+	}
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+// decimalBits — сколько бит мантиссы нужно для digits десятичных цифр (с запасом).
+func decimalBits(digits int) uint {
+	return uint(math.Ceil(float64(digits)*math.Log2(10))) + 16
+}
+
+// evalDecimal — вычисляет дерево в числах big.Float точности prec бит.
+func evalDecimal(n node, prec uint, env Env) (*big.Float, error) {
+	if err := env.interrupted(); err != nil {
+		return nil, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		f, ok := new(big.Float).SetPrec(prec).SetString(n.text)
+		if !ok {
+			return nil, &SyntaxError{Offset: n.pos, Token: n.text, Message: "malformed number"}
+		}
+		return f, nil
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			return nil, unsupportedNode(n)
+		}
+		f, ok := new(big.Float).SetPrec(prec).SetString(value)
+		if !ok {
+			return nil, fmt.Errorf("variable %q has malformed value %q", n.name, value)
+		}
+		return f, nil
+	case *callNode:
+		f, ok := env.Functions[n.name]
+		if !ok {
+			return nil, unsupportedNode(n)
+		}
+		args := make([]*big.Float, len(n.args))
+		for i, arg := range n.args {
+			x, err := evalDecimal(arg, prec, env)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = x
+		}
+		return f.callDecimal(prec, args)
+	case *unaryNode:
+		x, err := evalDecimal(n.x, prec, env)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "-" {
+			x.Neg(x)
+		}
+		return x, nil
+	case *binaryNode:
+		x, err := evalDecimal(n.x, prec, env)
+		if err != nil {
+			return nil, err
+		}
+		y, err := evalDecimal(n.y, prec, env)
+		if err != nil {
+			return nil, err
+		}
+		z := new(big.Float).SetPrec(prec)
+		switch n.op {
+		case "+":
+			return z.Add(x, y), nil
+		case "-":
+			return z.Sub(x, y), nil
+		case "*":
+			return z.Mul(x, y), nil
+		case "/":
+			if y.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			return z.Quo(x, y), nil
+		case "%":
+			if y.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			q := z.Quo(x, y)
+			floor := floorFloat(q)
+			return new(big.Float).SetPrec(prec).Sub(x, floor.Mul(floor, y)), nil
+		case "^":
+			return powFloat(x, y, prec, n.pos, env.Limits)
+		}
+	}
+	return nil, unsupportedNode(n)
+}
+
+// floorFloat — наибольшее целое, не превосходящее x.
+func floorFloat(x *big.Float) *big.Float {
+	i, acc := x.Int(nil) // усечение к нулю
+	if x.Sign() < 0 && acc != big.Exact {
+		i.Sub(i, big.NewInt(1))
+	}
+	return new(big.Float).SetPrec(x.Prec()).SetInt(i)
+}
+
+// powFloat — возведение в целую степень быстрым возведением в квадрат.
+func powFloat(x, y *big.Float, prec uint, pos int, limits Limits) (*big.Float, error) {
+	if !y.IsInt() {
+		return nil, &SyntaxError{Offset: pos, Token: "^", Message: "decimal mode supports only integer exponents"}
+	}
+	k, acc := y.Int64()
+	if acc != big.Exact {
+		return hugePowFloat(x, y, prec)
+	}
+	if x.Sign() == 0 && k < 0 {
+		return nil, ErrDivisionByZero
+	}
+	if x.Sign() != 0 {
+		// |x| ≈ 2^(exp-1), значит |x^k| ≈ 2^((exp-1)*k): большое при
+		// положительном показателе, малое (и допустимое) при отрицательном.
+		if bits := float64(x.MantExp(nil)-1) * float64(k); bits > 0 {
+			if err := limits.checkDigits(bitsToDigits(bits)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	neg := k < 0
+	if neg {
+		k = -k
+	}
+	result := new(big.Float).SetPrec(prec).SetInt64(1)
+	base := new(big.Float).SetPrec(prec).Set(x)
+	for k > 0 {
+		if k&1 == 1 {
+			result.Mul(result, base)
+		}
+		base.Mul(base, base)
+		k >>= 1
+	}
+	if neg {
+		result.Quo(new(big.Float).SetPrec(prec).SetInt64(1), result)
+	}
+	return result, nil
+}
+
+// hugePowFloat — x^y для целого y вне int64. Результат есть, только если
+// |x^y| не растёт: 0 и ±1 в любой степени, |x| < 1 в положительной и
+// |x| > 1 в отрицательной степени — такой результат меньше любого числа
+// точности prec и равен нулю.
+func hugePowFloat(x, y *big.Float, prec uint) (*big.Float, error) {
+	cmp := new(big.Float).Abs(x).Cmp(big.NewFloat(1))
+	switch {
+	case x.Sign() == 0 && y.Sign() < 0:
+		return nil, ErrDivisionByZero
+	case x.Sign() == 0:
+		return new(big.Float).SetPrec(prec), nil
+	case cmp == 0:
+		result := new(big.Float).SetPrec(prec).SetInt64(1)
+		// y = mant × 2^exp: y нечётно, если младший бит мантиссы — 2^0.
+		if exp := y.MantExp(nil); x.Sign() < 0 && exp == int(y.MinPrec()) {
+			result.Neg(result)
+		}
+		return result, nil
+	case (cmp < 0) == (y.Sign() > 0):
+		return new(big.Float).SetPrec(prec), nil
+	}
+	return nil, errExponentTooLarge(y.Text('g', 10))
+}
+
+// formatRat — точная запись дроби: целое число, конечная десятичная дробь
+// (если знаменатель раскладывается только на 2 и 5) или "p/q".
+func formatRat(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	den := new(big.Int).Set(r.Denom())
+	twos, fives := 0, 0
+	two, five, zero := big.NewInt(2), big.NewInt(5), new(big.Int)
+	mod := new(big.Int)
+	for mod.Mod(den, two).Cmp(zero) == 0 {
+		den.Quo(den, two)
+		twos++
+	}
+	for mod.Mod(den, five).Cmp(zero) == 0 {
+		den.Quo(den, five)
+		fives++
+	}
+	if den.Cmp(big.NewInt(1)) != 0 {
+		return r.String()
+	}
+	return r.FloatString(max(twos, fives))
+}
+
+// formatDecimal — запись числа с digits значащими цифрами без хвостовых нулей.
+// Очень большие и очень маленькие числа записываются в экспоненциальной форме.
+func formatDecimal(f *big.Float, digits int) string {
+	if f.Sign() == 0 {
+		return "0"
+	}
+	text := f.Text('e', digits-1) // например "-1.2340000e+05"
+	mantissa, expText, _ := strings.Cut(text, "e")
+	exp, _ := strconv.Atoi(expText)
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") {
+		sign, mantissa = "-", mantissa[1:]
+	}
+	ds := strings.TrimRight(strings.Replace(mantissa, ".", "", 1), "0")
+	if ds == "" {
+		ds = "0"
+	}
+
+	if exp >= digits || exp < -digits {
+		m := ds[:1]
+		if len(ds) > 1 {
+			m += "." + ds[1:]
+		}
+		return fmt.Sprintf("%s%se%+d", sign, m, exp)
+	}
+
+	point := exp + 1
+	switch {
+	case point <= 0:
+		return sign + "0." + strings.Repeat("0", -point) + ds
+	case point >= len(ds):
+		return sign + ds + strings.Repeat("0", point-len(ds))
+	default:
+		return sign + ds[:point] + "." + ds[point:]
+	}
+}
+
+// unsupportedNode — ошибка для конструкций, которых движок не умеет вычислять.
+func unsupportedNode(n node) error {
+	switch n := n.(type) {
+	case *identNode:
+		return &NameError{Err: ErrUnknownVariable, Name: n.name, Offset: n.pos}
+	case *callNode:
+		return &NameError{Err: ErrUnknownFunction, Name: n.name, Offset: n.pos}
+	default:
+		return fmt.Errorf("unsupported expression at offset %d", n.offset())
+	}
+}
@@ -0,0 +1,93 @@
+package calculationService
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBignumEvaluator(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		env        Env
+		want       string
+		wantErr    error
+	}{
+		{name: "0.1+0.2 без ошибки округления", expression: "0.1+0.2", env: Env{Mode: ModeRational}, want: "0.3"},
+		{name: "дробь остаётся дробью", expression: "1/3", env: Env{Mode: ModeRational}, want: "1/3"},
+		{name: "большие целые без потери цифр", expression: "2^100", env: Env{Mode: ModeRational}, want: "1267650600228229401496703205376"},
+		{name: "отрицательная степень", expression: "2^-2", env: Env{Mode: ModeRational}, want: "0.25"},
+		{name: "приоритет операций", expression: "-(1+2)*3 % 4", env: Env{Mode: ModeRational}, want: "3"},
+		{name: "decimal с заданной точностью", expression: "1/3", env: Env{Mode: ModeDecimal, Precision: 5}, want: "0.33333"},
+		{name: "decimal 0.1+0.2", expression: "0.1+0.2", env: Env{Mode: ModeDecimal, Precision: DefaultDecimalPrecision}, want: "0.3"},
+		{name: "деление на ноль", expression: "1/(2-2)", env: Env{Mode: ModeRational}, wantErr: ErrDivisionByZero},
+	}
+
+	engine := NewBignumEvaluator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			program, err := engine.Parse(tt.expression, tt.env)
+			assert.NoError(t, err)
+
+			result, err := engine.Evaluate(context.Background(), program, tt.env)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result.Result)
+		})
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	_, err := NewBignumEvaluator().Parse("2 + * 3", Env{Mode: ModeRational})
+
+	var syntaxErr *SyntaxError
+	if assert.ErrorAs(t, err, &syntaxErr) {
+		assert.Equal(t, 4, syntaxErr.Offset)
+		assert.Equal(t, "*", syntaxErr.Token)
+	}
+}
+
+func TestCreateCalculationModeSelection(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       EvalOptions
+		wantEngine string
+		wantResult string
+		wantErr    error
+	}{
+		{name: "режим без движка выбирает bignum", opts: EvalOptions{Mode: ModeRational}, wantEngine: BignumEngine, wantResult: "0.3"},
+		{name: "float по умолчанию", opts: EvalOptions{}, wantEngine: DefaultEngine, wantResult: "0.30000000000000004"},
+		{name: "govaluate не умеет rational", opts: EvalOptions{Engine: DefaultEngine, Mode: ModeRational}, wantErr: ErrUnsupportedMode},
+		{name: "точность вне диапазона", opts: EvalOptions{Mode: ModeDecimal, Precision: MaxDecimalPrecision + 1}, wantErr: ErrInvalidPrecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateCalculation", mock.Anything, mock.MatchedBy(func(c Calculation) bool {
+					return c.Result == tt.wantResult && c.Engine == tt.wantEngine
+				})).Return(nil)
+			}
+
+			service := NewCalculationService(mockRepo)
+			result, err := service.CreateCalculation(t.Context(), "0.1+0.2", "", tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantEngine, result.Engine)
+				assert.Equal(t, tt.wantResult, result.Result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package calculationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strconv"
+	"strings"
+)
+
+// ComplexEngine — имя движка комплексных чисел.
+const ComplexEngine = "complex"
+
+// Записи комплексного результата.
+const (
+	FormRectangular = "rectangular" // алгебраическая: "11-2i" (по умолчанию)
+	FormPolar       = "polar"       // показательная: модуль∠аргумент, "5∠0.927295218001612"
+)
+
+// ErrInvalidComplexForm — неизвестная запись комплексного результата.
+var ErrInvalidComplexForm = errors.New("invalid complex form")
+
+// imaginaryUnit — имя мнимой единицы; переменная с тем же именем важнее.
+const imaginaryUnit = "i"
+
+// complexEvaluator — движок комплексных чисел (complex128): "(3+4i)*(1-2i)",
+// sqrt(-4) = 2i, abs(3+4i) = 5. Мнимые числа записываются суффиксом i
+// (4i, 2.5i) или через мнимую единицу i. Встроенные функции считаются
+// комплексными версиями (см. Function.complex), conj, arg, re и im дают
+// сопряжённое число, аргумент, вещественную и мнимую части. Результат с
+// нулевой мнимой частью — обычное число (ResultNumber), иначе он
+// записывается в форме Env.ComplexForm (ResultComplex).
+type complexEvaluator struct{}
+
+// NewComplexEvaluator — создаёт движок комплексных чисел.
+func NewComplexEvaluator() Evaluator {
+	return complexEvaluator{}
+}
+
+func (complexEvaluator) Name() string { return ComplexEngine }
+
+func (complexEvaluator) Capabilities() Capabilities {
+	return Capabilities{
+		Description: "Complex numbers (complex128): imaginary literals like 4i, the imaginary unit i, complex versions of built-in functions, conj/arg/re/im, rectangular or polar results",
+		Modes:       []string{ModeComplex},
+		Variables:   true,
+		Functions:   true,
+	}
+}
+
+// Parse — разбирает выражение; имя i, если нет такой переменной, заменяется
+// мнимой единицей.
+func (complexEvaluator) Parse(expression string, env Env) (Program, error) {
+	root, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if err := env.Limits.checkTree(expression, root); err != nil {
+		return nil, err
+	}
+	return &astProgram{source: expression, root: resolveImaginary(root, env.Variables)}, nil
+}
+
+// resolveImaginary — заменяет в дереве имя мнимой единицы числом 1i.
+func resolveImaginary(n node, vars map[string]string) node {
+	switch n := n.(type) {
+	case *identNode:
+		if _, ok := vars[n.name]; !ok && n.name == imaginaryUnit {
+			return &numberNode{text: "1i", pos: n.pos}
+		}
+	case *unaryNode:
+		n.x = resolveImaginary(n.x, vars)
+	case *binaryNode:
+		n.x = resolveImaginary(n.x, vars)
+		n.y = resolveImaginary(n.y, vars)
+	case *callNode:
+		for i := range n.args {
+			n.args[i] = resolveImaginary(n.args[i], vars)
+		}
+	}
+	return n
+}
+
+func (complexEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	p, ok := program.(*astProgram)
+	if !ok {
+		return Evaluation{}, fmt.Errorf("complex: foreign program %T", program)
+	}
+	env.ctx = ctx
+
+	z, err := evalComplex(p.root, env)
+	if err != nil {
+		return Evaluation{}, err
+	}
+	switch {
+	case cmplx.IsNaN(z):
+		return Evaluation{}, fmt.Errorf("%w: result is not a number", ErrDomain)
+	case cmplx.IsInf(z):
+		return Evaluation{}, fmt.Errorf("%w: result is infinite", ErrOverflow)
+	}
+	if imag(z) == 0 {
+		return Evaluation{Result: formatQuantity(real(z)), Type: ResultNumber}, nil
+	}
+	return Evaluation{Result: formatComplex(z, env), Type: ResultComplex}, nil
+}
+
+// validateComplexForm — проверяет запись результата; пустая означает FormRectangular.
+func validateComplexForm(form string) (string, error) {
+	switch form {
+	case "":
+		return FormRectangular, nil
+	case FormRectangular, FormPolar:
+		return form, nil
+	}
+	return "", fmt.Errorf("%w: %q (allowed %s, %s)", ErrInvalidComplexForm, form, FormRectangular, FormPolar)
+}
+
+// formatComplex — комплексное число в форме env.ComplexForm. Аргумент
+// полярной формы — в единицах env.AngleUnit (в градусах со знаком °).
+func formatComplex(z complex128, env Env) string {
+	if env.ComplexForm == FormPolar {
+		angle := formatQuantity(fromRadians(env, cmplx.Phase(z)))
+		if env.AngleUnit == AngleDegrees {
+			angle += "°"
+		}
+		return formatQuantity(cmplx.Abs(z)) + "∠" + angle
+	}
+	re, im := real(z), imag(z)
+	if re == 0 {
+		return formatQuantity(im) + "i"
+	}
+	sign := "+"
+	if im < 0 {
+		sign, im = "-", -im
+	}
+	return formatQuantity(re) + sign + formatQuantity(im) + "i"
+}
+
+// parseComplex — число в записи "3", "4i", "3+4i" или "(3+4i)".
+func parseComplex(text string) (complex128, error) {
+	return strconv.ParseComplex(strings.TrimSpace(text), 128)
+}
+
+// evalComplex — вычисляет дерево в комплексных числах с переменными и функциями из env.
+func evalComplex(n node, env Env) (complex128, error) {
+	if err := env.interrupted(); err != nil {
+		return 0, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		z, err := parseComplex(n.text)
+		if err != nil {
+			return 0, &SyntaxError{Offset: n.pos, Token: n.text, Message: "malformed number"}
+		}
+		return z, nil
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			// тела функций пользователя разбираются без resolveImaginary
+			if n.name == imaginaryUnit {
+				return 1i, nil
+			}
+			return 0, unsupportedNode(n)
+		}
+		z, err := parseComplex(value)
+		if err != nil {
+			return 0, fmt.Errorf("variable %q has malformed value %q", n.name, value)
+		}
+		return z, nil
+	case *callNode:
+		f, ok := env.Functions[n.name]
+		if !ok {
+			return 0, unsupportedNode(n)
+		}
+		args := make([]complex128, len(n.args))
+		for i, arg := range n.args {
+			z, err := evalComplex(arg, env)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = z
+		}
+		return f.callComplex(env, args)
+	case *unaryNode:
+		z, err := evalComplex(n.x, env)
+		if err != nil {
+			return 0, err
+		}
+		if n.op == "-" {
+			// 0 - z, а не -z: у -4 мнимая часть должна остаться +0, иначе
+			// sqrt(-4) и ln(-1) попадут на другой берег разреза (-2i, -pi*i).
+			return 0 - z, nil
+		}
+		return z, nil
+	case *binaryNode:
+		x, err := evalComplex(n.x, env)
+		if err != nil {
+			return 0, err
+		}
+		y, err := evalComplex(n.y, env)
+		if err != nil {
+			return 0, err
+		}
+		switch n.op {
+		case "+":
+			return x + y, nil
+		case "-":
+			return x - y, nil
+		case "*":
+			return x * y, nil
+		case "/":
+			if y == 0 {
+				return 0, ErrDivisionByZero
+			}
+			return x / y, nil
+		case "%":
+			if imag(x) != 0 || imag(y) != 0 {
+				return 0, fmt.Errorf("%w: remainder of complex numbers", ErrDomain)
+			}
+			if y == 0 {
+				return 0, ErrDivisionByZero
+			}
+			return complex(math.Mod(real(x), real(y)), 0), nil
+		case "^":
+			return cmplx.Pow(x, y), nil
+		}
+	}
+	return 0, unsupportedNode(n)
+}
@@ -0,0 +1,69 @@
+package calculationService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestComplex(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		opts       EvalOptions
+		want       string
+		wantType   string
+		wantErr    error
+	}{
+		{name: "произведение", expression: "(3+4i)*(1-2i)", want: "11-2i", wantType: ResultComplex},
+		{name: "корень из отрицательного", expression: "sqrt(-4)", want: "2i", wantType: ResultComplex},
+		{name: "модуль — вещественное число", expression: "abs(3+4i)", want: "5", wantType: ResultNumber},
+		{name: "мнимая единица", expression: "i * i", want: "-1", wantType: ResultNumber},
+		{name: "сопряжённое", expression: "conj(3+4i)", want: "3-4i", wantType: ResultComplex},
+		{name: "части числа", expression: "re(3+4i) + im(3+4i)", want: "7", wantType: ResultNumber},
+		{name: "аргумент", expression: "arg(i)", want: "1.5707963267949", wantType: ResultNumber},
+		{name: "аргумент в градусах", expression: "arg(-1)", opts: EvalOptions{AngleUnit: AngleDegrees}, want: "180", wantType: ResultNumber},
+		{name: "полярная форма", expression: "3+4i", opts: EvalOptions{ComplexForm: FormPolar}, want: "5∠0.927295218001612", wantType: ResultComplex},
+		{name: "полярная форма в градусах", expression: "1+i", opts: EvalOptions{ComplexForm: FormPolar, AngleUnit: AngleDegrees}, want: "1.4142135623731∠45°", wantType: ResultComplex},
+		{name: "логарифм отрицательного", expression: "ln(-1)", want: "3.14159265358979i", wantType: ResultComplex},
+		{name: "деление на ноль", expression: "(1+2i) / 0", wantErr: ErrDivisionByZero},
+		{name: "остаток комплексных", expression: "(1+i) % 2", wantErr: ErrDomain},
+		{name: "логарифм нуля", expression: "ln(0)", wantErr: ErrDomain},
+		{name: "функция без комплексной версии", expression: "factorial(3)", wantErr: ErrNotExact},
+		{name: "неизвестная запись", expression: "1", opts: EvalOptions{ComplexForm: "exponential"}, wantErr: ErrInvalidComplexForm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service := NewCalculationService(mockRepo)
+			tt.opts.Mode = ModeComplex
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, ComplexEngine, result.Engine)
+				assert.Equal(t, tt.want, result.Result)
+				assert.Equal(t, tt.wantType, result.ResultType)
+			}
+		})
+	}
+}
+
+func TestComplexVariableShadowsImaginaryUnit(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil)
+
+	service := NewCalculationService(mockRepo, WithVariableSource(staticVariables{"i": "2"}))
+	result, err := service.CreateCalculation(t.Context(), "3i + i", "alice", EvalOptions{Mode: ModeComplex})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2+3i", result.Result)
+	assert.Equal(t, Bindings{"i": "2"}, result.Variables)
+}
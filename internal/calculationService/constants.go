@@ -0,0 +1,140 @@
+package calculationService
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Constant — встроенная именованная константа, доступная в любом выражении.
+type Constant struct {
+	Name        string
+	Value       string // десятичная запись с 64 значащими цифрами
+	Description string
+}
+
+// constants — встроенные константы. Имена зарезервированы:
+// пользовательская переменная не может их перекрыть.
+var constants = map[string]Constant{
+	"pi":    {Name: "pi", Value: "3.141592653589793238462643383279502884197169399375105820974944592", Description: "Ratio of a circle's circumference to its diameter"},
+	"tau":   {Name: "tau", Value: "6.283185307179586476925286766559005768394338798750211641949889185", Description: "2*pi, one full turn in radians"},
+	"e":     {Name: "e", Value: "2.718281828459045235360287471352662497757247093699959574966967628", Description: "Euler's number, base of the natural logarithm"},
+	"phi":   {Name: "phi", Value: "1.618033988749894848204586834365638117720309179805762862135448623", Description: "Golden ratio (1+sqrt(5))/2"},
+	"sqrt2": {Name: "sqrt2", Value: "1.414213562373095048801688724209698078569671875376948073176679738", Description: "Square root of 2"},
+	"ln2":   {Name: "ln2", Value: "0.6931471805599453094172321214581765680755001343602552541206800095", Description: "Natural logarithm of 2"},
+	"ln10":  {Name: "ln10", Value: "2.302585092994045684017991454684364207601101488628772976033327901", Description: "Natural logarithm of 10"},
+}
+
+// Constants — встроенные константы, отсортированные по имени.
+func Constants() []Constant {
+	list := make([]Constant, 0, len(constants))
+	for _, c := range constants {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// IsConstant — занято ли имя встроенной константой.
+func IsConstant(name string) bool {
+	_, ok := constants[name]
+	return ok
+}
+
+// ErrUnknownVariable — в выражении есть имя, которое не является ни
+// константой, ни переменной пользователя.
+var ErrUnknownVariable = errors.New("unknown variable")
+
+// VariableSource — откуда сервис берёт переменные пользователя.
+// Значения — десятичные записи чисел, например "0.2".
+type VariableSource interface {
+	VariablesForUser(ctx context.Context, userID string) (map[string]string, error)
+}
+
+// WithVariableSource — подключает хранилище переменных пользователей.
+// Без него в выражениях доступны только встроенные константы.
+func WithVariableSource(src VariableSource) Option {
+	return func(s *calcService) {
+		s.variables = src
+	}
+}
+
+// Bindings — значения по имени, сохранённые вместе с вычислением: переменные
+// и константы или определения функций пользователя.
+// В БД хранится как JSON-объект в текстовой колонке.
+type Bindings map[string]string
+
+// GormDataType — тип колонки, одинаковый для всех СУБД.
+func (Bindings) GormDataType() string { return "text" }
+
+// Value — сериализация в JSON для записи в БД.
+func (b Bindings) Value() (driver.Value, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(map[string]string(b))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan — чтение JSON из БД.
+func (b *Bindings) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*b = nil
+		return nil
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("bindings: unsupported column type %T", value)
+	}
+	if len(data) == 0 {
+		*b = nil
+		return nil
+	}
+	return json.Unmarshal(data, (*map[string]string)(b))
+}
+
+// scope — константы и переменные пользователя, доступные выражению.
+func (s *calcService) scope(ctx context.Context, userID string) (map[string]string, error) {
+	vars := make(map[string]string, len(constants))
+	if s.variables != nil && userID != "" {
+		userVars, err := s.variables.VariablesForUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range userVars {
+			vars[name] = value
+		}
+	}
+	// Константы добавляем последними: их нельзя перекрыть.
+	for name, c := range constants {
+		vars[name] = c.Value
+	}
+	return vars, nil
+}
+
+// bind — значения имён, на которые ссылается программа.
+func bind(program Program, vars map[string]string) (Bindings, error) {
+	names := program.Variables()
+	if len(names) == 0 {
+		return nil, nil
+	}
+	used := make(Bindings, len(names))
+	for _, name := range names {
+		value, ok := vars[name]
+		if !ok {
+			return nil, &NameError{Err: ErrUnknownVariable, Name: name, Offset: nameOffset(program.Source(), name)}
+		}
+		used[name] = value
+	}
+	return used, nil
+}
@@ -0,0 +1,258 @@
+package calculationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// DefaultEngine — движок, которым считаются выражения, если другой не выбран.
+const DefaultEngine = "govaluate"
+
+// Режимы точности вычислений.
+const (
+	ModeFloat    = "float"    // float64: быстро, но 0.1+0.2 = 0.30000000000000004
+	ModeRational = "rational" // точные рациональные дроби (math/big.Rat)
+	ModeDecimal  = "decimal"  // десятичные числа с заданным числом значащих цифр (math/big.Float)
+	ModeUnits    = "units"    // float64 с единицами измерения и проверкой размерностей
+
+	ModeProgrammer = "programmer" // целые фиксированной ширины (Env.WordType) с побитовыми операциями
+	ModeComplex    = "complex"    // комплексные числа complex128: "(3+4i)*(1-2i)", sqrt(-4)
+	ModeMatrix     = "matrix"     // векторы и матрицы float64: "[[1,2],[3,4]] * [5,6]", det(A)
+)
+
+const (
+	// DefaultDecimalPrecision — значащих цифр в режиме decimal, если точность не задана.
+	DefaultDecimalPrecision = 34
+	// MaxDecimalPrecision — верхняя граница точности режима decimal.
+	MaxDecimalPrecision = 1000
+)
+
+var (
+	// ErrUnknownEngine — запрошен движок, который не зарегистрирован в сервисе.
+	ErrUnknownEngine = errors.New("unknown expression engine")
+	// ErrUnsupportedMode — ни один подходящий движок не поддерживает запрошенный режим.
+	ErrUnsupportedMode = errors.New("unsupported evaluation mode")
+	// ErrInvalidPrecision — точность вне диапазона 1..MaxDecimalPrecision.
+	ErrInvalidPrecision = errors.New("invalid precision")
+	// ErrDivisionByZero — деление (или остаток от деления) на ноль.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrOverflow — результат вышел за пределы float64 (±Inf).
+	ErrOverflow = errors.New("arithmetic overflow")
+)
+
+// Типы результата вычисления (Calculation.ResultType): по нему клиент
+// понимает, как читать строку Result.
+const (
+	ResultNumber  = "number"  // вещественное число: "4", "1/3", "2.5e-7"
+	ResultComplex = "complex" // комплексное число: "11-2i" или "5∠0.927295218001612"
+)
+
+// Capabilities — описание возможностей движка вычислений.
+type Capabilities struct {
+	Description string   // человекочитаемое описание движка
+	Modes       []string // поддерживаемые режимы точности; первый — режим по умолчанию
+	Variables   bool     // поддерживает ли параметры (переменные) в выражении
+	Functions   bool     // поддерживает ли вызовы функций
+}
+
+// defaultMode — режим движка по умолчанию; движок без списка режимов считает во float64.
+func (c Capabilities) defaultMode() string {
+	if len(c.Modes) == 0 {
+		return ModeFloat
+	}
+	return c.Modes[0]
+}
+
+// supports — поддерживает ли движок режим точности.
+func (c Capabilities) supports(mode string) bool {
+	if len(c.Modes) == 0 {
+		return mode == ModeFloat
+	}
+	for _, m := range c.Modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// Env — окружение одного вычисления: выбранный режим и его параметры.
+type Env struct {
+	Mode      string // режим вычисления (ModeFloat, ModeRational, ModeDecimal, ModeUnits, ModeProgrammer, ...)
+	Precision int    // значащих цифр для ModeDecimal; для других режимов 0
+	WordType  string // тип слова для ModeProgrammer (WordInt8..WordUint64); для других режимов ""
+
+	// ComplexForm — запись комплексного результата в ModeComplex
+	// (FormRectangular, FormPolar); для других режимов "".
+	ComplexForm string
+
+	// AngleUnit — единицы углов тригонометрических функций (AngleRadians, AngleDegrees).
+	AngleUnit string
+
+	// Variables — значения констант и переменных пользователя по имени
+	// (десятичные записи чисел, например "0.2").
+	Variables map[string]string
+
+	// Functions — функции, доступные выражению, по имени.
+	Functions map[string]*Function
+
+	// Rates — курсы валют для ModeUnits: сколько единиц валюты дают за одну
+	// базовую (у базовой валюты "1"), по коду валюты, например "EUR": "0.92".
+	Rates map[string]string
+
+	// Limits — ограничения, которые движок проверяет при разборе
+	// (токены, глубина) и вычислении (размер степеней).
+	Limits Limits
+
+	ctx context.Context // для проверки времени внутри вычисления; см. interrupted
+}
+
+// Program — разобранное выражение, готовое к вычислению.
+// Конкретный тип знает только создавший его движок.
+type Program interface {
+	// Source — исходный текст выражения.
+	Source() string
+	// Variables — имена переменных, на которые ссылается выражение.
+	Variables() []string
+}
+
+// Evaluation — результат вычисления выражения движком.
+type Evaluation struct {
+	Result string // результат в текстовом виде (например, "4")
+	Unit   string // единица результата (например, "km/h"); пустая — просто число
+	Type   string // тип результата (ResultNumber, ResultComplex, ResultVector, ResultMatrix); пустой — ResultNumber
+
+	// Rates — курсы валют, использованных в выражении (из Env.Rates).
+	Rates Bindings
+}
+
+// Evaluator — движок вычисления выражений. Сервис не знает, как устроена
+// математика внутри: он разбирает выражение, вычисляет его и сохраняет результат.
+type Evaluator interface {
+	// Name — уникальное имя движка, сохраняется в Calculation.Engine.
+	Name() string
+	// Capabilities — что умеет движок.
+	Capabilities() Capabilities
+	// Parse — разбирает выражение; синтаксические ошибки и превышение
+	// env.Limits по числу токенов и глубине возвращаются здесь.
+	Parse(expression string, env Env) (Program, error)
+	// Evaluate — вычисляет ранее разобранное выражение. Когда ctx истекает,
+	// движок должен вернуть ErrTimeout, не дожидаясь конца вычисления.
+	Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error)
+}
+
+// Option — настройка сервиса при создании через NewCalculationService.
+type Option func(*calcService)
+
+// WithEvaluator — регистрирует движок (или заменяет движок с тем же именем).
+func WithEvaluator(e Evaluator) Option {
+	return func(s *calcService) {
+		s.engines[e.Name()] = e
+	}
+}
+
+// WithDefaultEngine — задаёт движок для запросов, в которых движок не указан.
+func WithDefaultEngine(name string) Option {
+	return func(s *calcService) {
+		s.defaultEngine = name
+	}
+}
+
+// EvalOptions — параметры вычисления, выбираемые для конкретного запроса.
+type EvalOptions struct {
+	Engine    string // имя движка; пустое — движок по умолчанию (или первый, умеющий Mode)
+	Mode      string // режим точности; пустой — режим движка по умолчанию
+	Precision int    // значащих цифр для ModeDecimal; 0 — DefaultDecimalPrecision
+	AngleUnit string // единицы углов; пустое — радианы
+	WordType  string // тип слова для ModeProgrammer; пустое — DefaultWordType
+
+	ComplexForm string // запись результата ModeComplex; пустая — FormRectangular
+}
+
+// EngineInfo — имя движка и его возможности.
+type EngineInfo struct {
+	Name         string
+	Capabilities Capabilities
+}
+
+// evaluator — находит движок по имени; пустое имя означает движок по умолчанию.
+func (s *calcService) evaluator(name string) (Evaluator, error) {
+	if name == "" {
+		name = s.defaultEngine
+	}
+	e, ok := s.engines[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEngine, name)
+	}
+	return e, nil
+}
+
+// resolve — выбирает движок и окружение по параметрам запроса.
+// Если движок не указан, а режим указан, берётся движок по умолчанию,
+// когда он умеет этот режим, иначе первый по имени движок, который умеет.
+func (s *calcService) resolve(opts EvalOptions) (Evaluator, Env, error) {
+	engine, err := s.evaluator(opts.Engine)
+	if err != nil {
+		return nil, Env{}, err
+	}
+
+	mode := opts.Mode
+	if mode == "" {
+		mode = engine.Capabilities().defaultMode()
+	}
+	if !engine.Capabilities().supports(mode) {
+		if opts.Engine != "" {
+			return nil, Env{}, fmt.Errorf("%w: engine %q has no %q mode", ErrUnsupportedMode, engine.Name(), mode)
+		}
+		engine = nil
+		for _, info := range s.Engines() {
+			if info.Capabilities.supports(mode) {
+				engine = s.engines[info.Name]
+				break
+			}
+		}
+		if engine == nil {
+			return nil, Env{}, fmt.Errorf("%w: %q", ErrUnsupportedMode, mode)
+		}
+	}
+
+	angleUnit, err := validateAngleUnit(opts.AngleUnit)
+	if err != nil {
+		return nil, Env{}, err
+	}
+
+	env := Env{Mode: mode, AngleUnit: angleUnit, Limits: s.limits}
+	if mode == ModeDecimal {
+		env.Precision = opts.Precision
+		if env.Precision == 0 {
+			env.Precision = DefaultDecimalPrecision
+		}
+		if env.Precision < 1 || env.Precision > MaxDecimalPrecision {
+			return nil, Env{}, fmt.Errorf("%w: %d (allowed 1..%d)", ErrInvalidPrecision, env.Precision, MaxDecimalPrecision)
+		}
+	}
+	if mode == ModeProgrammer {
+		if env.WordType, err = validateWordType(opts.WordType); err != nil {
+			return nil, Env{}, err
+		}
+	}
+	if mode == ModeComplex {
+		if env.ComplexForm, err = validateComplexForm(opts.ComplexForm); err != nil {
+			return nil, Env{}, err
+		}
+	}
+
+	return engine, env, nil
+}
+
+// Engines — список зарегистрированных движков, отсортированный по имени.
+func (s *calcService) Engines() []EngineInfo {
+	infos := make([]EngineInfo, 0, len(s.engines))
+	for name, e := range s.engines {
+		infos = append(infos, EngineInfo{Name: name, Capabilities: e.Capabilities()})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
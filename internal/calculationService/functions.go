@@ -0,0 +1,624 @@
+package calculationService
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"sort"
+)
+
+// Единицы измерения углов для тригонометрических функций.
+const (
+	AngleRadians = "radians" // по умолчанию
+	AngleDegrees = "degrees"
+)
+
+var (
+	// ErrUnknownFunction — вызвана функция, которой нет ни среди встроенных, ни у пользователя.
+	ErrUnknownFunction = errors.New("unknown function")
+	// ErrArity — функция вызвана с неподходящим числом аргументов.
+	ErrArity = errors.New("wrong number of arguments")
+	// ErrDomain — аргумент вне области определения функции (sqrt(-1), ln(0), 2.5!).
+	ErrDomain = errors.New("argument out of domain")
+	// ErrInvalidAngleUnit — неизвестная единица измерения углов.
+	ErrInvalidAngleUnit = errors.New("invalid angle unit")
+	// ErrNotExact — функция не имеет точной версии для режимов rational и decimal.
+	ErrNotExact = errors.New("function is not available in this mode")
+)
+
+// maxFactorial — наибольший аргумент factorial, comb и perm в точных режимах.
+const maxFactorial = 5000
+
+// Function — функция, доступная в выражениях. Реализация для float64
+// есть у всех функций; точные версии — только у тех, что не порождают
+// иррациональных чисел (и у sqrt в режиме decimal); комплексные — у
+// аналитических функций и функций комплексного числа (conj, arg, re, im).
+type Function struct {
+	Name        string
+	Category    string // trigonometry, hyperbolic, logarithm, root, rounding, combinatorics, complex
+	MinArgs     int
+	MaxArgs     int // -1 — без ограничения
+	Description string
+
+	float   func(env Env, args []float64) (float64, error)
+	exact   func(args []*big.Rat) (*big.Rat, error)
+	decimal func(prec uint, args []*big.Float) (*big.Float, error)
+	complex func(env Env, args []complex128) (complex128, error)
+}
+
+// Modes — режимы, в которых функция доступна; float-версия работает и в units.
+func (f *Function) Modes() []string {
+	modes := []string{ModeFloat, ModeUnits}
+	if f.exact != nil {
+		modes = append(modes, ModeRational)
+	}
+	if f.exact != nil || f.decimal != nil {
+		modes = append(modes, ModeDecimal)
+	}
+	if f.complex != nil {
+		modes = append(modes, ModeComplex)
+	}
+	return modes
+}
+
+// checkArity — проверяет число аргументов вызова.
+func (f *Function) checkArity(n int) error {
+	if n < f.MinArgs || (f.MaxArgs >= 0 && n > f.MaxArgs) {
+		want := fmt.Sprint(f.MinArgs)
+		switch {
+		case f.MaxArgs < 0:
+			want += " or more"
+		case f.MaxArgs != f.MinArgs:
+			want += fmt.Sprintf("..%d", f.MaxArgs)
+		}
+		return fmt.Errorf("%w: %s takes %s, got %d", ErrArity, f.Name, want, n)
+	}
+	return nil
+}
+
+// callFloat — вызывает функцию в режиме float64.
+func (f *Function) callFloat(env Env, args []float64) (float64, error) {
+	if err := f.checkArity(len(args)); err != nil {
+		return 0, err
+	}
+	result, err := f.float(env, args)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(result) {
+		return 0, fmt.Errorf("%w: %s", ErrDomain, f.Name)
+	}
+	return result, nil
+}
+
+// callExact — вызывает точную версию функции.
+func (f *Function) callExact(args []*big.Rat) (*big.Rat, error) {
+	if err := f.checkArity(len(args)); err != nil {
+		return nil, err
+	}
+	if f.exact == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotExact, f.Name)
+	}
+	return f.exact(args)
+}
+
+// callDecimal — вызывает функцию в режиме decimal: своя реализация на big.Float
+// или точная версия над дробями (значения big.Float переводятся в big.Rat без потерь).
+func (f *Function) callDecimal(prec uint, args []*big.Float) (*big.Float, error) {
+	if err := f.checkArity(len(args)); err != nil {
+		return nil, err
+	}
+	if f.decimal != nil {
+		return f.decimal(prec, args)
+	}
+	if f.exact == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotExact, f.Name)
+	}
+	rats := make([]*big.Rat, len(args))
+	for i, a := range args {
+		rats[i], _ = a.Rat(nil)
+	}
+	r, err := f.exact(rats)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Float).SetPrec(prec).SetRat(r), nil
+}
+
+// callComplex — вызывает функцию в режиме complex.
+func (f *Function) callComplex(env Env, args []complex128) (complex128, error) {
+	if err := f.checkArity(len(args)); err != nil {
+		return 0, err
+	}
+	if f.complex == nil {
+		return 0, fmt.Errorf("%w: %s", ErrNotExact, f.Name)
+	}
+	result, err := f.complex(env, args)
+	if err != nil {
+		return 0, err
+	}
+	if cmplx.IsNaN(result) {
+		return 0, fmt.Errorf("%w: %s", ErrDomain, f.Name)
+	}
+	return result, nil
+}
+
+// builtinFunctions — встроенная библиотека функций по имени.
+var builtinFunctions = map[string]*Function{}
+
+func init() {
+	for _, f := range []*Function{
+		// Тригонометрия: аргументы прямых и результаты обратных функций — в единицах Env.AngleUnit.
+		{Name: "sin", Category: "trigonometry", MinArgs: 1, MaxArgs: 1, Description: "Sine", float: trig(math.Sin, func(sin, cos float64) (float64, error) { return sin, nil }), complex: ctrig(cmplx.Sin)},
+		{Name: "cos", Category: "trigonometry", MinArgs: 1, MaxArgs: 1, Description: "Cosine", float: trig(math.Cos, func(sin, cos float64) (float64, error) { return cos, nil }), complex: ctrig(cmplx.Cos)},
+		{Name: "tan", Category: "trigonometry", MinArgs: 1, MaxArgs: 1, Description: "Tangent", float: trig(math.Tan, func(sin, cos float64) (float64, error) {
+			if cos == 0 {
+				return 0, fmt.Errorf("%w: tangent of a right angle", ErrDomain)
+			}
+			return sin / cos, nil
+		}), complex: ctrig(cmplx.Tan)},
+		{Name: "asin", Category: "trigonometry", MinArgs: 1, MaxArgs: 1, Description: "Inverse sine", float: arcTrig(math.Asin), complex: carcTrig(cmplx.Asin)},
+		{Name: "acos", Category: "trigonometry", MinArgs: 1, MaxArgs: 1, Description: "Inverse cosine", float: arcTrig(math.Acos), complex: carcTrig(cmplx.Acos)},
+		{Name: "atan", Category: "trigonometry", MinArgs: 1, MaxArgs: 1, Description: "Inverse tangent", float: arcTrig(math.Atan), complex: carcTrig(cmplx.Atan)},
+		{Name: "atan2", Category: "trigonometry", MinArgs: 2, MaxArgs: 2, Description: "Angle of the point (x, y): atan2(y, x)", float: func(env Env, a []float64) (float64, error) {
+			return fromRadians(env, math.Atan2(a[0], a[1])), nil
+		}},
+
+		{Name: "sinh", Category: "hyperbolic", MinArgs: 1, MaxArgs: 1, Description: "Hyperbolic sine", float: unary(math.Sinh), complex: cunary(cmplx.Sinh)},
+		{Name: "cosh", Category: "hyperbolic", MinArgs: 1, MaxArgs: 1, Description: "Hyperbolic cosine", float: unary(math.Cosh), complex: cunary(cmplx.Cosh)},
+		{Name: "tanh", Category: "hyperbolic", MinArgs: 1, MaxArgs: 1, Description: "Hyperbolic tangent", float: unary(math.Tanh), complex: cunary(cmplx.Tanh)},
+		{Name: "asinh", Category: "hyperbolic", MinArgs: 1, MaxArgs: 1, Description: "Inverse hyperbolic sine", float: unary(math.Asinh), complex: cunary(cmplx.Asinh)},
+		{Name: "acosh", Category: "hyperbolic", MinArgs: 1, MaxArgs: 1, Description: "Inverse hyperbolic cosine", float: unary(math.Acosh), complex: cunary(cmplx.Acosh)},
+		{Name: "atanh", Category: "hyperbolic", MinArgs: 1, MaxArgs: 1, Description: "Inverse hyperbolic tangent", float: unary(math.Atanh), complex: cunary(cmplx.Atanh)},
+
+		{Name: "ln", Category: "logarithm", MinArgs: 1, MaxArgs: 1, Description: "Natural logarithm", float: positive(math.Log), complex: nonzero(cmplx.Log)},
+		{Name: "log", Category: "logarithm", MinArgs: 1, MaxArgs: 2, Description: "Logarithm: log(x) is base 10, log(x, b) is base b", float: logBase, complex: clogBase},
+		{Name: "log10", Category: "logarithm", MinArgs: 1, MaxArgs: 1, Description: "Base-10 logarithm", float: positive(math.Log10), complex: nonzero(cmplx.Log10)},
+		{Name: "log2", Category: "logarithm", MinArgs: 1, MaxArgs: 1, Description: "Base-2 logarithm", float: positive(math.Log2), complex: nonzero(func(z complex128) complex128 { return cmplx.Log(z) / math.Ln2 })},
+		{Name: "exp", Category: "logarithm", MinArgs: 1, MaxArgs: 1, Description: "e raised to the power x", float: unary(math.Exp), complex: cunary(cmplx.Exp)},
+
+		{Name: "sqrt", Category: "root", MinArgs: 1, MaxArgs: 1, Description: "Square root", float: unary(math.Sqrt), decimal: sqrtDecimal, complex: cunary(cmplx.Sqrt)},
+		{Name: "cbrt", Category: "root", MinArgs: 1, MaxArgs: 1, Description: "Cube root", float: unary(math.Cbrt)},
+		{Name: "root", Category: "root", MinArgs: 2, MaxArgs: 2, Description: "n-th root: root(x, n)", float: nthRoot},
+		{Name: "hypot", Category: "root", MinArgs: 2, MaxArgs: 2, Description: "sqrt(x^2 + y^2) without intermediate overflow", float: func(env Env, a []float64) (float64, error) {
+			return math.Hypot(a[0], a[1]), nil
+		}},
+
+		{Name: "abs", Category: "rounding", MinArgs: 1, MaxArgs: 1, Description: "Absolute value (modulus of a complex number)", float: unary(math.Abs), exact: func(a []*big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Abs(a[0]), nil
+		}, complex: func(env Env, a []complex128) (complex128, error) {
+			return complex(cmplx.Abs(a[0]), 0), nil
+		}},
+		{Name: "sign", Category: "rounding", MinArgs: 1, MaxArgs: 1, Description: "Sign of x: -1, 0 or 1", float: func(env Env, a []float64) (float64, error) {
+			switch {
+			case a[0] > 0:
+				return 1, nil
+			case a[0] < 0:
+				return -1, nil
+			}
+			return 0, nil
+		}, exact: func(a []*big.Rat) (*big.Rat, error) {
+			return big.NewRat(int64(a[0].Sign()), 1), nil
+		}},
+		{Name: "floor", Category: "rounding", MinArgs: 1, MaxArgs: 1, Description: "Largest integer not greater than x", float: unary(math.Floor), exact: func(a []*big.Rat) (*big.Rat, error) {
+			return new(big.Rat).SetInt(floorRat(a[0])), nil
+		}},
+		{Name: "ceil", Category: "rounding", MinArgs: 1, MaxArgs: 1, Description: "Smallest integer not less than x", float: unary(math.Ceil), exact: func(a []*big.Rat) (*big.Rat, error) {
+			neg := new(big.Rat).Neg(a[0])
+			return new(big.Rat).SetInt(new(big.Int).Neg(floorRat(neg))), nil
+		}},
+		{Name: "trunc", Category: "rounding", MinArgs: 1, MaxArgs: 1, Description: "Integer part of x (rounds toward zero)", float: unary(math.Trunc), exact: func(a []*big.Rat) (*big.Rat, error) {
+			return new(big.Rat).SetInt(new(big.Int).Quo(a[0].Num(), a[0].Denom())), nil
+		}},
+		{Name: "round", Category: "rounding", MinArgs: 1, MaxArgs: 2, Description: "Round half away from zero: round(x) to an integer, round(x, n) to n decimal places", float: roundFloat, exact: roundExact},
+		{Name: "min", Category: "rounding", MinArgs: 1, MaxArgs: -1, Description: "Smallest of the arguments", float: func(env Env, a []float64) (float64, error) {
+			m := a[0]
+			for _, x := range a[1:] {
+				m = math.Min(m, x)
+			}
+			return m, nil
+		}, exact: func(a []*big.Rat) (*big.Rat, error) {
+			m := a[0]
+			for _, x := range a[1:] {
+				if x.Cmp(m) < 0 {
+					m = x
+				}
+			}
+			return m, nil
+		}},
+		{Name: "max", Category: "rounding", MinArgs: 1, MaxArgs: -1, Description: "Largest of the arguments", float: func(env Env, a []float64) (float64, error) {
+			m := a[0]
+			for _, x := range a[1:] {
+				m = math.Max(m, x)
+			}
+			return m, nil
+		}, exact: func(a []*big.Rat) (*big.Rat, error) {
+			m := a[0]
+			for _, x := range a[1:] {
+				if x.Cmp(m) > 0 {
+					m = x
+				}
+			}
+			return m, nil
+		}},
+
+		// Функции комплексного числа; у вещественного x мнимая часть 0, а аргумент 0 или pi.
+		{Name: "re", Category: "complex", MinArgs: 1, MaxArgs: 1, Description: "Real part", float: unary(func(x float64) float64 { return x }), exact: func(a []*big.Rat) (*big.Rat, error) {
+			return a[0], nil
+		}, complex: func(env Env, a []complex128) (complex128, error) {
+			return complex(real(a[0]), 0), nil
+		}},
+		{Name: "im", Category: "complex", MinArgs: 1, MaxArgs: 1, Description: "Imaginary part", float: unary(func(x float64) float64 { return 0 }), exact: func(a []*big.Rat) (*big.Rat, error) {
+			return new(big.Rat), nil
+		}, complex: func(env Env, a []complex128) (complex128, error) {
+			return complex(imag(a[0]), 0), nil
+		}},
+		{Name: "conj", Category: "complex", MinArgs: 1, MaxArgs: 1, Description: "Complex conjugate", float: unary(func(x float64) float64 { return x }), exact: func(a []*big.Rat) (*big.Rat, error) {
+			return a[0], nil
+		}, complex: func(env Env, a []complex128) (complex128, error) {
+			return cmplx.Conj(a[0]), nil
+		}},
+		{Name: "arg", Category: "complex", MinArgs: 1, MaxArgs: 1, Description: "Argument (phase angle) of a complex number", float: func(env Env, a []float64) (float64, error) {
+			return fromRadians(env, math.Atan2(0, a[0])), nil
+		}, complex: func(env Env, a []complex128) (complex128, error) {
+			return complex(fromRadians(env, cmplx.Phase(a[0])), 0), nil
+		}},
+
+		{Name: "factorial", Category: "combinatorics", MinArgs: 1, MaxArgs: 1, Description: "n! for a non-negative integer n", float: viaExact(factorial), exact: factorial},
+		{Name: "comb", Category: "combinatorics", MinArgs: 2, MaxArgs: 2, Description: "Number of k-combinations of n items: comb(n, k)", float: viaExact(comb), exact: comb},
+		{Name: "perm", Category: "combinatorics", MinArgs: 2, MaxArgs: 2, Description: "Number of k-permutations of n items: perm(n, k)", float: viaExact(perm), exact: perm},
+		{Name: "gcd", Category: "combinatorics", MinArgs: 2, MaxArgs: -1, Description: "Greatest common divisor of integers", float: viaExact(gcd), exact: gcd},
+		{Name: "lcm", Category: "combinatorics", MinArgs: 2, MaxArgs: -1, Description: "Least common multiple of integers", float: viaExact(lcm), exact: lcm},
+	} {
+		builtinFunctions[f.Name] = f
+	}
+}
+
+// FunctionInfo — описание функции для справки (GET /functions).
+type FunctionInfo struct {
+	Name        string
+	Category    string
+	MinArgs     int
+	MaxArgs     int // -1 — без ограничения
+	Description string
+	Modes       []string
+}
+
+// Functions — встроенные функции, отсортированные по имени.
+func Functions() []FunctionInfo {
+	infos := make([]FunctionInfo, 0, len(builtinFunctions))
+	for _, f := range builtinFunctions {
+		infos = append(infos, f.info())
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// IsBuiltinFunction — занято ли имя встроенной функцией.
+func IsBuiltinFunction(name string) bool {
+	_, ok := builtinFunctions[name]
+	return ok
+}
+
+func (f *Function) info() FunctionInfo {
+	return FunctionInfo{
+		Name:        f.Name,
+		Category:    f.Category,
+		MinArgs:     f.MinArgs,
+		MaxArgs:     f.MaxArgs,
+		Description: f.Description,
+		Modes:       f.Modes(),
+	}
+}
+
+// validateAngleUnit — проверяет единицу углов; пустая означает радианы.
+func validateAngleUnit(unit string) (string, error) {
+	switch unit {
+	case "":
+		return AngleRadians, nil
+	case AngleRadians, AngleDegrees:
+		return unit, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidAngleUnit, unit)
+}
+
+func unary(fn func(float64) float64) func(Env, []float64) (float64, error) {
+	return func(env Env, a []float64) (float64, error) { return fn(a[0]), nil }
+}
+
+// positive — логарифмы определены только для x > 0 (math.Log(0) = -Inf).
+func positive(fn func(float64) float64) func(Env, []float64) (float64, error) {
+	return func(env Env, a []float64) (float64, error) {
+		if a[0] <= 0 {
+			return 0, fmt.Errorf("%w: logarithm of %v", ErrDomain, a[0])
+		}
+		return fn(a[0]), nil
+	}
+}
+
+// trig — прямая тригонометрическая функция с учётом единиц углов.
+// В градусах углы, кратные 90°, дают точные 0 и ±1 вместо 6.1e-17:
+// right получает точные синус и косинус такого угла.
+func trig(fn func(float64) float64, right func(sin, cos float64) (float64, error)) func(Env, []float64) (float64, error) {
+	return func(env Env, a []float64) (float64, error) {
+		x := a[0]
+		if env.AngleUnit == AngleDegrees {
+			if !math.IsInf(x, 0) && math.Mod(x, 90) == 0 {
+				quarter := int(math.Mod(x, 360)/90+4) % 4
+				return right([4]float64{0, 1, 0, -1}[quarter], [4]float64{1, 0, -1, 0}[quarter])
+			}
+			x = x * math.Pi / 180
+		}
+		return fn(x), nil
+	}
+}
+
+// arcTrig — обратная тригонометрическая функция с результатом в единицах Env.AngleUnit.
+func arcTrig(fn func(float64) float64) func(Env, []float64) (float64, error) {
+	return func(env Env, a []float64) (float64, error) {
+		return fromRadians(env, fn(a[0])), nil
+	}
+}
+
+func fromRadians(env Env, x float64) float64 {
+	if env.AngleUnit == AngleDegrees {
+		return x * 180 / math.Pi
+	}
+	return x
+}
+
+// cunary — комплексная функция одного аргумента.
+func cunary(fn func(complex128) complex128) func(Env, []complex128) (complex128, error) {
+	return func(env Env, a []complex128) (complex128, error) { return fn(a[0]), nil }
+}
+
+// nonzero — комплексный логарифм определён везде, кроме нуля.
+func nonzero(fn func(complex128) complex128) func(Env, []complex128) (complex128, error) {
+	return func(env Env, a []complex128) (complex128, error) {
+		if a[0] == 0 {
+			return 0, fmt.Errorf("%w: logarithm of 0", ErrDomain)
+		}
+		return fn(a[0]), nil
+	}
+}
+
+// ctrig — комплексная тригонометрическая функция с учётом единиц углов.
+func ctrig(fn func(complex128) complex128) func(Env, []complex128) (complex128, error) {
+	return func(env Env, a []complex128) (complex128, error) {
+		z := a[0]
+		if env.AngleUnit == AngleDegrees {
+			z *= math.Pi / 180
+		}
+		return fn(z), nil
+	}
+}
+
+// carcTrig — обратная комплексная тригонометрическая функция; вещественная
+// часть результата — угол в единицах Env.AngleUnit.
+func carcTrig(fn func(complex128) complex128) func(Env, []complex128) (complex128, error) {
+	return func(env Env, a []complex128) (complex128, error) {
+		z := fn(a[0])
+		if env.AngleUnit == AngleDegrees {
+			z *= 180 / math.Pi
+		}
+		return z, nil
+	}
+}
+
+func clogBase(env Env, a []complex128) (complex128, error) {
+	if a[0] == 0 {
+		return 0, fmt.Errorf("%w: logarithm of 0", ErrDomain)
+	}
+	if len(a) == 1 {
+		return cmplx.Log10(a[0]), nil
+	}
+	if a[1] == 0 || a[1] == 1 {
+		return 0, fmt.Errorf("%w: logarithm base %v", ErrDomain, a[1])
+	}
+	return cmplx.Log(a[0]) / cmplx.Log(a[1]), nil
+}
+
+func logBase(env Env, a []float64) (float64, error) {
+	if a[0] <= 0 {
+		return 0, fmt.Errorf("%w: logarithm of %v", ErrDomain, a[0])
+	}
+	if len(a) == 1 {
+		return math.Log10(a[0]), nil
+	}
+	if a[1] <= 0 || a[1] == 1 {
+		return 0, fmt.Errorf("%w: logarithm base %v", ErrDomain, a[1])
+	}
+	return math.Log(a[0]) / math.Log(a[1]), nil
+}
+
+// nthRoot — корень степени n; для нечётных n определён и для отрицательных x.
+func nthRoot(env Env, a []float64) (float64, error) {
+	x, n := a[0], a[1]
+	if n == 0 {
+		return 0, fmt.Errorf("%w: zeroth root", ErrDomain)
+	}
+	if x < 0 {
+		if n != math.Trunc(n) || math.Mod(n, 2) == 0 {
+			return 0, fmt.Errorf("%w: even root of a negative number", ErrDomain)
+		}
+		return -math.Pow(-x, 1/n), nil
+	}
+	return math.Pow(x, 1/n), nil
+}
+
+func sqrtDecimal(prec uint, a []*big.Float) (*big.Float, error) {
+	if a[0].Sign() < 0 {
+		return nil, fmt.Errorf("%w: square root of a negative number", ErrDomain)
+	}
+	return new(big.Float).SetPrec(prec).Sqrt(a[0]), nil
+}
+
+func roundFloat(env Env, a []float64) (float64, error) {
+	if len(a) == 1 {
+		return math.Round(a[0]), nil
+	}
+	if a[1] != math.Trunc(a[1]) {
+		return 0, fmt.Errorf("%w: round digits must be an integer", ErrDomain)
+	}
+	scale := math.Pow(10, a[1])
+	return math.Round(a[0]*scale) / scale, nil
+}
+
+// roundExact — округление дроби до n знаков, половина — от нуля.
+func roundExact(a []*big.Rat) (*big.Rat, error) {
+	scale := big.NewRat(1, 1)
+	if len(a) == 2 {
+		n, err := smallInt(a[1], "round digits")
+		if err != nil {
+			return nil, err
+		}
+		pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(absInt(n)), nil)
+		if n >= 0 {
+			scale.SetInt(pow)
+		} else {
+			scale.SetFrac(big.NewInt(1), pow)
+		}
+	}
+	x := new(big.Rat).Mul(a[0], scale)
+	half := big.NewRat(1, 2)
+	if x.Sign() < 0 {
+		x.Sub(x, half)
+	} else {
+		x.Add(x, half)
+	}
+	i := new(big.Int).Quo(x.Num(), x.Denom()) // усечение к нулю
+	return new(big.Rat).Quo(new(big.Rat).SetInt(i), scale), nil
+}
+
+// floorRat — наибольшее целое, не превосходящее r. Div — евклидово деление:
+// при положительном знаменателе оно совпадает с округлением вниз.
+func floorRat(r *big.Rat) *big.Int {
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+// smallInt — аргумент, который должен быть небольшим целым числом.
+func smallInt(r *big.Rat, what string) (int64, error) {
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, fmt.Errorf("%w: %s must be an integer", ErrDomain, what)
+	}
+	return r.Num().Int64(), nil
+}
+
+// natural — неотрицательное целое не больше maxFactorial.
+func natural(r *big.Rat, fn string) (int64, error) {
+	n, err := smallInt(r, fn+" argument")
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 || n > maxFactorial {
+		return 0, fmt.Errorf("%w: %s argument must be between 0 and %d", ErrDomain, fn, maxFactorial)
+	}
+	return n, nil
+}
+
+func absInt(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func factorial(a []*big.Rat) (*big.Rat, error) {
+	n, err := natural(a[0], "factorial")
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).SetInt(new(big.Int).MulRange(1, n)), nil
+}
+
+func comb(a []*big.Rat) (*big.Rat, error) {
+	n, k, err := combArgs(a, "comb")
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).SetInt(new(big.Int).Binomial(n, k)), nil
+}
+
+func perm(a []*big.Rat) (*big.Rat, error) {
+	n, k, err := combArgs(a, "perm")
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).SetInt(new(big.Int).MulRange(n-k+1, n)), nil
+}
+
+func combArgs(a []*big.Rat, fn string) (n, k int64, err error) {
+	if n, err = natural(a[0], fn); err != nil {
+		return 0, 0, err
+	}
+	if k, err = natural(a[1], fn); err != nil {
+		return 0, 0, err
+	}
+	if k > n {
+		return 0, 0, fmt.Errorf("%w: %s(n, k) needs k <= n", ErrDomain, fn)
+	}
+	return n, k, nil
+}
+
+func integers(a []*big.Rat, fn string) ([]*big.Int, error) {
+	ints := make([]*big.Int, len(a))
+	for i, r := range a {
+		if !r.IsInt() {
+			return nil, fmt.Errorf("%w: %s arguments must be integers", ErrDomain, fn)
+		}
+		ints[i] = new(big.Int).Abs(r.Num())
+	}
+	return ints, nil
+}
+
+func gcd(a []*big.Rat) (*big.Rat, error) {
+	ints, err := integers(a, "gcd")
+	if err != nil {
+		return nil, err
+	}
+	g := ints[0]
+	for _, x := range ints[1:] {
+		g = new(big.Int).GCD(nil, nil, g, x)
+	}
+	return new(big.Rat).SetInt(g), nil
+}
+
+func lcm(a []*big.Rat) (*big.Rat, error) {
+	ints, err := integers(a, "lcm")
+	if err != nil {
+		return nil, err
+	}
+	l := ints[0]
+	for _, x := range ints[1:] {
+		if l.Sign() == 0 || x.Sign() == 0 {
+			l = new(big.Int)
+			continue
+		}
+		g := new(big.Int).GCD(nil, nil, l, x)
+		l = new(big.Int).Mul(new(big.Int).Quo(l, g), x)
+	}
+	return new(big.Rat).SetInt(l), nil
+}
+
+// viaExact — float-версия целочисленной функции через точную:
+// аргументы float64 переводятся в дроби, результат — обратно в float64.
+func viaExact(fn func([]*big.Rat) (*big.Rat, error)) func(Env, []float64) (float64, error) {
+	return func(env Env, a []float64) (float64, error) {
+		rats := make([]*big.Rat, len(a))
+		for i, x := range a {
+			r := new(big.Rat)
+			if r.SetFloat64(x) == nil {
+				return 0, fmt.Errorf("%w: %v", ErrDomain, x)
+			}
+			rats[i] = r
+		}
+		r, err := fn(rats)
+		if err != nil {
+			return 0, err
+		}
+		f, _ := r.Float64()
+		return f, nil
+	}
+}
@@ -0,0 +1,135 @@
+package calculationService
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBuiltinFunctions(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		opts       EvalOptions
+		want       string
+		wantErr    error
+	}{
+		{name: "корень", expression: "sqrt(16)", want: "4"},
+		{name: "синус в градусах", expression: "sin(90) + cos(180)", opts: EvalOptions{AngleUnit: AngleDegrees}, want: "0"},
+		{name: "арктангенс в градусах", expression: "atan(1)", opts: EvalOptions{AngleUnit: AngleDegrees}, want: "45"},
+		{name: "логарифм по основанию", expression: "log(8, 2)", want: "3"},
+		{name: "переменное число аргументов", expression: "max(1, 5, 3) - min(4, 2)", want: "3"},
+		{name: "факториал", expression: "factorial(5)", want: "120"},
+		{name: "сочетания", expression: "comb(5, 2)", want: "10"},
+		{name: "точный факториал", expression: "factorial(25)", opts: EvalOptions{Mode: ModeRational}, want: "15511210043330985984000000"},
+		{name: "точное округление", expression: "round(1/3, 3) + floor(-1/2)", opts: EvalOptions{Mode: ModeRational}, want: "-0.667"},
+		{name: "корень в decimal", expression: "sqrt(2)", opts: EvalOptions{Mode: ModeDecimal, Precision: 20}, want: "1.4142135623730950488"},
+		{name: "неизвестная функция", expression: "foo(1)", wantErr: ErrUnknownFunction},
+		{name: "неизвестная функция в точном режиме", expression: "foo(1)", opts: EvalOptions{Mode: ModeRational}, wantErr: ErrUnknownFunction},
+		{name: "вне области определения", expression: "sqrt(-1)", wantErr: ErrDomain},
+		{name: "неверное число аргументов", expression: "sqrt(1, 2)", opts: EvalOptions{Mode: ModeDecimal}, wantErr: ErrArity},
+		{name: "нет точной версии", expression: "sin(1)", opts: EvalOptions{Mode: ModeRational}, wantErr: ErrNotExact},
+		{name: "неизвестные единицы углов", expression: "sin(1)", opts: EvalOptions{AngleUnit: "grads"}, wantErr: ErrInvalidAngleUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service := NewCalculationService(mockRepo)
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, result.Result)
+			}
+		})
+	}
+}
+
+// staticFunctions — хранилище функций пользователя с фиксированным набором
+type staticFunctions []FunctionDefinition
+
+func (f staticFunctions) FunctionsForUser(_ context.Context, userID string) ([]FunctionDefinition, error) {
+	return f, nil
+}
+
+func TestUserFunctions(t *testing.T) {
+	defs := staticFunctions{
+		{Name: "f", Params: []string{"x", "y"}, Body: "x^2 + y"},
+		{Name: "g", Params: []string{"x"}, Body: "f(x, 1) * k"},
+	}
+
+	tests := []struct {
+		name          string
+		expression    string
+		opts          EvalOptions
+		want          string
+		wantFunctions Bindings
+		wantErr       error
+	}{
+		{name: "вызов функции", expression: "f(3, 4)", want: "13", wantFunctions: Bindings{"f": "f(x, y) = x^2 + y"}},
+		{name: "функция через функцию", expression: "g(2)", want: "50", wantFunctions: Bindings{"f": "f(x, y) = x^2 + y", "g": "g(x) = f(x, 1) * k"}},
+		{name: "точный режим", expression: "f(1/3, 0)", opts: EvalOptions{Mode: ModeRational}, want: "1/9", wantFunctions: Bindings{"f": "f(x, y) = x^2 + y"}},
+		{name: "режим decimal", expression: "f(0.1, 0.2)", opts: EvalOptions{Mode: ModeDecimal}, want: "0.21", wantFunctions: Bindings{"f": "f(x, y) = x^2 + y"}},
+		{name: "параметр перекрывает переменную", expression: "f(k, 0)", want: "100", wantFunctions: Bindings{"f": "f(x, y) = x^2 + y"}},
+		{name: "неверное число аргументов", expression: "f(1)", wantErr: ErrArity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service := NewCalculationService(mockRepo, WithFunctionSource(defs), WithVariableSource(staticVariables{"k": "10", "x": "7"}))
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "alice", tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, result.Result)
+				assert.Equal(t, tt.wantFunctions, result.Functions)
+			}
+		})
+	}
+}
+
+func TestValidateFunctions(t *testing.T) {
+	tests := []struct {
+		name    string
+		defs    []FunctionDefinition
+		wantErr error
+	}{
+		{name: "корректный набор", defs: []FunctionDefinition{{Name: "f", Params: []string{"x"}, Body: "sqrt(x) + 1"}}},
+		{name: "прямая рекурсия", defs: []FunctionDefinition{{Name: "f", Params: []string{"x"}, Body: "f(x - 1)"}}, wantErr: ErrRecursion},
+		{name: "взаимная рекурсия", defs: []FunctionDefinition{
+			{Name: "f", Params: []string{"x"}, Body: "g(x)"},
+			{Name: "g", Params: []string{"x"}, Body: "f(x)"},
+		}, wantErr: ErrRecursion},
+		{name: "арность встроенной функции", defs: []FunctionDefinition{{Name: "f", Params: []string{"x"}, Body: "sqrt(x, 2)"}}, wantErr: ErrArity},
+		{name: "арность функции пользователя", defs: []FunctionDefinition{
+			{Name: "f", Params: []string{"x", "y"}, Body: "x + y"},
+			{Name: "g", Params: []string{"x"}, Body: "f(x)"},
+		}, wantErr: ErrArity},
+		{name: "неизвестная функция", defs: []FunctionDefinition{{Name: "f", Params: []string{"x"}, Body: "h(x)"}}, wantErr: ErrUnknownFunction},
+		{name: "имя встроенной функции", defs: []FunctionDefinition{{Name: "sin", Params: []string{"x"}, Body: "x"}}, wantErr: ErrInvalidDefinition},
+		{name: "повтор параметра", defs: []FunctionDefinition{{Name: "f", Params: []string{"x", "x"}, Body: "x"}}, wantErr: ErrInvalidDefinition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFunctions(tt.defs)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
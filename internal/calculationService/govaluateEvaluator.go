@@ -0,0 +1,193 @@
+package calculationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Knetic/govaluate"
+)
+
+// govaluateEvaluator — движок на базе github.com/Knetic/govaluate (float64).
+type govaluateEvaluator struct{}
+
+// govaluateProgram — выражение, разобранное govaluate.
+type govaluateProgram struct {
+	source string
+	expr   *govaluate.EvaluableExpression
+}
+
+func (p *govaluateProgram) Source() string { return p.source }
+
+func (p *govaluateProgram) Variables() []string {
+	if p.expr == nil {
+		return nil
+	}
+	return p.expr.Vars()
+}
+
+// NewGovaluateEvaluator — создаёт движок govaluate, используемый по умолчанию.
+func NewGovaluateEvaluator() Evaluator {
+	return govaluateEvaluator{}
+}
+
+func (govaluateEvaluator) Name() string { return DefaultEngine }
+
+func (govaluateEvaluator) Capabilities() Capabilities {
+	return Capabilities{
+		Description: "Floating-point arithmetic (float64) powered by govaluate",
+		Modes:       []string{ModeFloat},
+		Variables:   true,
+		Functions:   true,
+	}
+}
+
+func (govaluateEvaluator) Parse(expression string, env Env) (Program, error) {
+	expr, err := govaluate.NewEvaluableExpressionWithFunctions(expression, govaluateFunctions(env))
+	if err != nil {
+		// govaluate не различает неизвестную функцию и опечатку — уточняем сами.
+		if unknown := unknownCall(expression, env.Functions); unknown != nil {
+			return nil, unknown
+		}
+		return nil, govaluateSyntaxError(expression, err)
+	}
+	if err := env.Limits.checkTokens(len(expr.Tokens())); err != nil {
+		return nil, err
+	}
+	if err := env.Limits.checkDepth(govaluateDepth(expression, expr.Tokens())); err != nil {
+		return nil, err
+	}
+	return &govaluateProgram{source: expression, expr: expr}, nil
+}
+
+// govaluateSyntaxError — ошибка разбора govaluate в виде SyntaxError.
+// Если выражение не разбирает и собственный разборщик, берём его ошибку:
+// в ней точные позиция и токен. Иначе позицию ищем по токену, который
+// govaluate указывает в квадратных скобках: "... to VARIABLE [e300]".
+func govaluateSyntaxError(expression string, err error) error {
+	if _, ownErr := parseExpression(expression); ownErr != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(ownErr, &syntaxErr) {
+			return syntaxErr
+		}
+	}
+	msg := err.Error()
+	syntaxErr := &SyntaxError{Offset: -1, Message: msg}
+	if open := strings.LastIndex(msg, "["); open >= 0 {
+		if end := strings.Index(msg[open:], "]"); end > 0 {
+			syntaxErr.Token = msg[open+1 : open+end]
+			if i := strings.Index(expression, syntaxErr.Token); i >= 0 {
+				syntaxErr.Offset = utf8.RuneCountInString(expression[:i])
+			}
+		}
+	}
+	return syntaxErr
+}
+
+// govaluateDepth — глубина выражения. Дерево govaluate закрыто, поэтому
+// выражение разбирается собственным разборщиком; синтаксис, которого он не
+// знает (сравнения, логика, строки), оценивается по вложенности скобок.
+func govaluateDepth(expression string, tokens []govaluate.ExpressionToken) int {
+	if root, err := parseExpression(expression); err == nil {
+		return depth(root)
+	}
+	level, deepest := 1, 1
+	for _, t := range tokens {
+		switch t.Kind {
+		case govaluate.CLAUSE:
+			level++
+			deepest = max(deepest, level)
+		case govaluate.CLAUSE_CLOSE:
+			level--
+		}
+	}
+	return deepest
+}
+
+func (govaluateEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	p, ok := program.(*govaluateProgram)
+	if !ok {
+		return Evaluation{}, fmt.Errorf("govaluate: foreign program %T", program)
+	}
+
+	params := make(map[string]interface{}, len(env.Variables))
+	for _, name := range p.expr.Vars() {
+		value, ok := env.Variables[name]
+		if !ok {
+			return Evaluation{}, &NameError{Err: ErrUnknownVariable, Name: name, Offset: nameOffset(p.source, name)}
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return Evaluation{}, fmt.Errorf("variable %q: %w", name, err)
+		}
+		params[name] = f
+	}
+
+	// govaluate нельзя прервать изнутри: вычисляем в отдельной горутине и
+	// перестаём ждать по ctx. Функции пользователя сами проверяют время
+	// (env.interrupted), поэтому горутина тоже скоро завершится.
+	type outcome struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := p.expr.Evaluate(params)
+		done <- outcome{result, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return Evaluation{}, timeoutError(ctx, env.Limits.Timeout)
+	case o := <-done:
+		if o.err != nil {
+			return Evaluation{}, o.err // Ошибка при вычислении
+		}
+		if f, ok := o.result.(float64); ok && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			if err := explainNonFinite(p.source, env); err != nil {
+				return Evaluation{}, err
+			}
+		}
+		return Evaluation{Result: fmt.Sprintf("%v", o.result)}, nil
+	}
+}
+
+// explainNonFinite — govaluate делит на ноль без ошибки, возвращая ±Inf
+// или NaN. Чтобы назвать причину, выражение перевычисляется собственным
+// разборщиком, который сообщает о делении на ноль; nil — причину не нашли
+// (тогда результат проверит checkResult).
+func explainNonFinite(expression string, env Env) error {
+	root, err := parseExpression(expression)
+	if err != nil {
+		return nil
+	}
+	if _, err := evalFloat(root, env); errors.Is(err, ErrDivisionByZero) {
+		return err
+	}
+	return nil
+}
+
+// govaluateFunctions — функции из env в виде, который понимает govaluate.
+// Окружение (например, единицы углов) захватывается при разборе.
+func govaluateFunctions(env Env) map[string]govaluate.ExpressionFunction {
+	functions := make(map[string]govaluate.ExpressionFunction, len(env.Functions))
+	for name, f := range env.Functions {
+		f := f
+		functions[name] = func(args ...interface{}) (interface{}, error) {
+			floats := make([]float64, len(args))
+			for i, arg := range args {
+				x, ok := arg.(float64)
+				if !ok {
+					return nil, fmt.Errorf("%s: argument %d is not a number", f.Name, i+1)
+				}
+				floats[i] = x
+			}
+			return f.callFloat(env, floats)
+		}
+	}
+	return functions
+}
@@ -0,0 +1,245 @@
+package calculationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxExpressionLength — размер колонки expression (VARCHAR(255)); длиннее
+// выражение не сохранить, поэтому Limits.MaxLength не может его превышать.
+const MaxExpressionLength = 255
+
+// Машиночитаемые коды нарушенных ограничений (LimitError.Code).
+const (
+	CodeExpressionTooLong = "expression_too_long"
+	CodeTooManyTokens     = "too_many_tokens"
+	CodeExpressionTooDeep = "expression_too_deep"
+	CodeTimeout           = "evaluation_timeout"
+	CodeResultTooLarge    = "result_too_large"
+)
+
+// LimitError — выражение или его вычисление вышли за одно из ограничений.
+// errors.Is сравнивает ошибки по коду, поэтому с ErrExpressionTooLong и
+// другими значениями ниже можно сравнивать ошибку с подробностями.
+type LimitError struct {
+	Code    string // один из Code*
+	Message string
+}
+
+func (e *LimitError) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Message
+}
+
+// Is — ошибки с одинаковым кодом считаются одной ошибкой.
+func (e *LimitError) Is(target error) bool {
+	t, ok := target.(*LimitError)
+	return ok && t.Code == e.Code
+}
+
+var (
+	// ErrExpressionTooLong — в выражении больше Limits.MaxLength символов.
+	ErrExpressionTooLong = &LimitError{Code: CodeExpressionTooLong}
+	// ErrTooManyTokens — в выражении больше Limits.MaxTokens токенов.
+	ErrTooManyTokens = &LimitError{Code: CodeTooManyTokens}
+	// ErrExpressionTooDeep — дерево разбора глубже Limits.MaxDepth.
+	ErrExpressionTooDeep = &LimitError{Code: CodeExpressionTooDeep}
+	// ErrTimeout — вычисление не уложилось в Limits.Timeout.
+	ErrTimeout = &LimitError{Code: CodeTimeout}
+	// ErrResultTooLarge — результат (или промежуточная степень) длиннее Limits.MaxResultDigits цифр.
+	ErrResultTooLarge = &LimitError{Code: CodeResultTooLarge}
+)
+
+// Limits — ограничения на выражение и его вычисление.
+// Нулевое поле (кроме MaxLength) означает «без ограничения».
+type Limits struct {
+	MaxLength int           // символов в выражении; не больше MaxExpressionLength
+	MaxTokens int           // токенов (чисел, имён, операторов и скобок)
+	MaxDepth  int           // глубина дерева разбора
+	Timeout   time.Duration // время на одно вычисление
+
+	// MaxResultDigits — десятичных цифр в целой части результата; для дробей
+	// режима rational — в числителе и в знаменателе.
+	MaxResultDigits int
+}
+
+// DefaultLimits — ограничения, с которыми создаётся сервис.
+func DefaultLimits() Limits {
+	return Limits{
+		MaxLength:       MaxExpressionLength,
+		MaxTokens:       200,
+		MaxDepth:        100,
+		Timeout:         2 * time.Second,
+		MaxResultDigits: 1000,
+	}
+}
+
+// WithLimits — заменяет ограничения по умолчанию.
+func WithLimits(l Limits) Option {
+	return func(s *calcService) {
+		if l.MaxLength <= 0 || l.MaxLength > MaxExpressionLength {
+			l.MaxLength = MaxExpressionLength
+		}
+		s.limits = l
+	}
+}
+
+// checkLength — проверка длины до разбора.
+func (l Limits) checkLength(expression string) error {
+	if n := utf8.RuneCountInString(expression); l.MaxLength > 0 && n > l.MaxLength {
+		return &LimitError{Code: CodeExpressionTooLong, Message: fmt.Sprintf("expression has %d characters, limit is %d", n, l.MaxLength)}
+	}
+	return nil
+}
+
+// checkTokens — проверка числа токенов после разбора.
+func (l Limits) checkTokens(n int) error {
+	if l.MaxTokens > 0 && n > l.MaxTokens {
+		return &LimitError{Code: CodeTooManyTokens, Message: fmt.Sprintf("expression has %d tokens, limit is %d", n, l.MaxTokens)}
+	}
+	return nil
+}
+
+// checkDepth — проверка глубины дерева разбора.
+func (l Limits) checkDepth(depth int) error {
+	if l.MaxDepth > 0 && depth > l.MaxDepth {
+		return &LimitError{Code: CodeExpressionTooDeep, Message: fmt.Sprintf("expression nests %d levels deep, limit is %d", depth, l.MaxDepth)}
+	}
+	return nil
+}
+
+// checkDigits — проверка числа цифр результата или промежуточного значения.
+func (l Limits) checkDigits(digits int) error {
+	if l.MaxResultDigits > 0 && digits == math.MaxInt {
+		return &LimitError{Code: CodeResultTooLarge, Message: fmt.Sprintf("result has too many digits, limit is %d", l.MaxResultDigits)}
+	}
+	if l.MaxResultDigits > 0 && digits > l.MaxResultDigits {
+		return &LimitError{Code: CodeResultTooLarge, Message: fmt.Sprintf("result has about %d digits, limit is %d", digits, l.MaxResultDigits)}
+	}
+	return nil
+}
+
+// checkResult — проверка текстового результата любого движка: "+Inf" и
+// "NaN" из float64, десятичная или экспоненциальная запись, дробь "p/q".
+func (l Limits) checkResult(result string) error {
+	if strings.Contains(result, "Inf") {
+		return fmt.Errorf("%w: result is infinite", ErrOverflow)
+	}
+	if result == "NaN" {
+		return fmt.Errorf("%w: result is not a number", ErrDomain)
+	}
+	for _, part := range strings.Split(result, "/") {
+		if err := l.checkDigits(integerDigits(part)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// integerDigits — сколько цифр в целой части числа, записанного как
+// 12.5, -1.2e+300 или 123456; для нечисловой записи — 0.
+func integerDigits(s string) int {
+	s = strings.TrimLeft(s, "+-")
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return 0
+		}
+		mantissa, exponent = s[:i], exp
+	}
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		// 0.00123e5: ведущие нули дробной части уменьшают порядок.
+		trimmed := strings.TrimLeft(frac, "0")
+		if trimmed == "" {
+			return 0
+		}
+		exponent -= len(frac) - len(trimmed)
+		return max(exponent, 0)
+	}
+	return max(len(whole)+exponent, 0)
+}
+
+// errExponentTooLarge — показатель степени не помещается в int64, а
+// основание не 0 и не ±1: результат длиннее любого ограничения числа цифр.
+func errExponentTooLarge(exponent string) error {
+	return &LimitError{Code: CodeResultTooLarge, Message: fmt.Sprintf("exponent %s is too large", exponent)}
+}
+
+// bitsToDigits — приблизительное число десятичных цифр в числе из bits бит.
+// Оценка больше MaxInt (и +Inf) даёт MaxInt: при переводе в int она
+// переполнилась бы в отрицательное число и прошла бы checkDigits.
+func bitsToDigits(bits float64) int {
+	digits := math.Ceil(bits * math.Log10(2))
+	if !(digits < math.MaxInt) {
+		return math.MaxInt
+	}
+	return int(digits)
+}
+
+// depth — глубина дерева разбора (лист — 1).
+func depth(n node) int {
+	switch n := n.(type) {
+	case *unaryNode:
+		return 1 + depth(n.x)
+	case *binaryNode:
+		return 1 + max(depth(n.x), depth(n.y))
+	case *callNode:
+		d := 0
+		for _, arg := range n.args {
+			d = max(d, depth(arg))
+		}
+		return 1 + d
+	case *convertNode:
+		return 1 + max(depth(n.x), depth(n.unit))
+	case *arrayNode:
+		d := 0
+		for _, elem := range n.elems {
+			d = max(d, depth(elem))
+		}
+		return 1 + d
+	default:
+		return 1
+	}
+}
+
+// checkTree — проверка числа токенов и глубины дерева собственного разборщика.
+func (l Limits) checkTree(expression string, root node) error {
+	tokens, err := lex(expression)
+	if err != nil {
+		return err
+	}
+	if err := l.checkTokens(len(tokens) - 1); err != nil { // без tokEOF
+		return err
+	}
+	return l.checkDepth(depth(root))
+}
+
+// interrupted — ErrTimeout, если время вычисления истекло.
+// Движки на собственном разборщике проверяют его в каждом узле дерева.
+func (e Env) interrupted() error {
+	if e.ctx == nil || e.ctx.Err() == nil {
+		return nil
+	}
+	return timeoutError(e.ctx, e.Limits.Timeout)
+}
+
+// timeoutError — ErrTimeout с подробностями, если истёк Limits.Timeout
+// (причина ctx — ErrTimeout, см. calculateExpression); иначе ошибка ctx:
+// срок всего запроса (DBTimeout) или его отмена.
+func timeoutError(ctx context.Context, timeout time.Duration) error {
+	if errors.Is(context.Cause(ctx), ErrTimeout) {
+		return &LimitError{Code: CodeTimeout, Message: fmt.Sprintf("evaluation took longer than %s", timeout)}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,184 @@
+package calculationService
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// slowEvaluator — движок-заглушка, который считает, пока не истечёт ctx
+type slowEvaluator struct{}
+
+func (slowEvaluator) Name() string               { return "slow" }
+func (slowEvaluator) Capabilities() Capabilities { return Capabilities{} }
+
+func (slowEvaluator) Parse(expression string, env Env) (Program, error) {
+	return &govaluateProgram{source: expression}, nil
+}
+
+func (slowEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	<-ctx.Done()
+	return Evaluation{}, timeoutError(ctx, env.Limits.Timeout)
+}
+
+func TestLimits(t *testing.T) {
+	limits := DefaultLimits()
+	limits.Timeout = 50 * time.Millisecond
+
+	tests := []struct {
+		name       string
+		expression string
+		opts       EvalOptions
+		limits     Limits
+		wantErr    error
+	}{
+		{name: "слишком длинное", expression: strings.Repeat("1+", 130) + "1", wantErr: ErrExpressionTooLong},
+		{name: "много токенов", expression: strings.Repeat("1+", 20) + "1", limits: Limits{MaxTokens: 10}, wantErr: ErrTooManyTokens},
+		{name: "много токенов в точном режиме", expression: strings.Repeat("1+", 20) + "1", opts: EvalOptions{Mode: ModeRational}, limits: Limits{MaxTokens: 10}, wantErr: ErrTooManyTokens},
+		{name: "глубокая вложенность", expression: strings.Repeat("-(", 30) + "1" + strings.Repeat(")", 30), limits: Limits{MaxDepth: 10}, wantErr: ErrExpressionTooDeep},
+		{name: "глубокая вложенность функций", expression: strings.Repeat("abs(", 20) + "1" + strings.Repeat(")", 20), opts: EvalOptions{Mode: ModeDecimal}, limits: Limits{MaxDepth: 10}, wantErr: ErrExpressionTooDeep},
+		{name: "огромная степень", expression: "2^100000000", opts: EvalOptions{Mode: ModeRational}, wantErr: ErrResultTooLarge},
+		{name: "огромная степень в decimal", expression: "10^100000000", opts: EvalOptions{Mode: ModeDecimal}, wantErr: ErrResultTooLarge},
+		{name: "оценка размера больше MaxInt", expression: "(10^999)^(10^18)", opts: EvalOptions{Mode: ModeRational}, wantErr: ErrResultTooLarge},
+		{name: "оценка размера больше MaxInt в decimal", expression: "(10^999)^(10^18)", opts: EvalOptions{Mode: ModeDecimal}, wantErr: ErrResultTooLarge},
+		{name: "большой результат", expression: "factorial(1000)", opts: EvalOptions{Mode: ModeRational}, wantErr: ErrResultTooLarge},
+		{name: "бесконечность", expression: "exp(1000)", opts: EvalOptions{Mode: ModeFloat}, wantErr: ErrOverflow},
+		{name: "маленький результат допустим", expression: "0.5^3000", opts: EvalOptions{Mode: ModeDecimal}},
+		{name: "таймаут", expression: "1", opts: EvalOptions{Engine: "slow"}, limits: limits, wantErr: ErrTimeout},
+		{name: "в пределах ограничений", expression: "2^64", opts: EvalOptions{Mode: ModeRational}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []Option{WithEvaluator(slowEvaluator{})}
+			if tt.limits != (Limits{}) {
+				opts = append(opts, WithLimits(tt.limits))
+			}
+			mockRepo := new(MockTaskRepository)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			service := NewCalculationService(mockRepo, opts...)
+			_, err := service.CreateCalculation(t.Context(), tt.expression, "", tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestRequestDeadline — срок всего запроса (DBTimeout), истёкший раньше
+// Limits.Timeout, — не evaluation_timeout, а ошибка контекста (503).
+func TestRequestDeadline(t *testing.T) {
+	limits := DefaultLimits()
+	limits.Timeout = time.Minute
+	service := NewCalculationService(new(MockTaskRepository), WithEvaluator(slowEvaluator{}), WithLimits(limits))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	_, err := service.CreateCalculation(ctx, "1", "", EvalOptions{Engine: "slow"})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, ErrTimeout)
+}
+
+func TestUserFunctionTimeout(t *testing.T) {
+	// Каждая функция вызывает предыдущую дважды: f29(1) — это 2^29 вызовов f0.
+	defs := []FunctionDefinition{{Name: "f0", Params: []string{"x"}, Body: "x + 1"}}
+	for i := 1; i < 30; i++ {
+		prev := defs[i-1].Name
+		defs = append(defs, FunctionDefinition{
+			Name:   fmt.Sprintf("f%d", i),
+			Params: []string{"x"},
+			Body:   prev + "(x) + " + prev + "(x)",
+		})
+	}
+	last := defs[len(defs)-1].Name
+
+	for _, mode := range []string{ModeFloat, ModeRational} {
+		t.Run(mode, func(t *testing.T) {
+			service := NewCalculationService(new(MockTaskRepository),
+				WithFunctionSource(staticFunctions(defs)),
+				WithLimits(Limits{Timeout: 50 * time.Millisecond}),
+			)
+
+			start := time.Now()
+			_, err := service.CreateCalculation(t.Context(), last+"(1)", "alice", EvalOptions{Mode: mode})
+
+			assert.ErrorIs(t, err, ErrTimeout)
+			assert.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+func TestIntegerDigits(t *testing.T) {
+	tests := map[string]int{
+		"0":          0,
+		"12.5":       2,
+		"-123456":    6,
+		"1.2e+300":   301,
+		"0.00123e5":  3,
+		"1e-20":      0,
+		"0.00000001": 0,
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, integerDigits(in), in)
+	}
+}
+
+func TestHugeExponent(t *testing.T) {
+	// Показатели вне int64 не доходят до big.Int.Exp, который не прервать
+	// таймаутом: результат либо точен без вычислений, либо слишком велик.
+	tests := []struct {
+		mode       string
+		expression string
+		want       string
+		wantErr    error
+	}{
+		{mode: ModeRational, expression: "2^(10^20)", wantErr: ErrResultTooLarge},
+		{mode: ModeDecimal, expression: "2^(10^20)", wantErr: ErrResultTooLarge},
+		{mode: ModeRational, expression: "2^1e20", wantErr: ErrResultTooLarge},
+		{mode: ModeDecimal, expression: "2^1e20", wantErr: ErrResultTooLarge},
+		{mode: ModeRational, expression: "(10^999)^(10^999)", wantErr: ErrResultTooLarge},
+		{mode: ModeDecimal, expression: "(10^999)^(10^999)", wantErr: ErrResultTooLarge},
+		{mode: ModeRational, expression: "0.5^(10^20)", wantErr: ErrResultTooLarge},
+		{mode: ModeRational, expression: "1^(10^20)", want: "1"},
+		{mode: ModeRational, expression: "(-1)^(10^20+1)", want: "-1"},
+		{mode: ModeRational, expression: "0^(10^20)", want: "0"},
+		{mode: ModeRational, expression: "0^-(10^20)", wantErr: ErrDivisionByZero},
+		{mode: ModeDecimal, expression: "(-1)^(10^20+1)", want: "-1"},
+		{mode: ModeDecimal, expression: "(-1)^(10^20)", want: "1"},
+		{mode: ModeDecimal, expression: "0.5^(10^20)", want: "0"},
+		{mode: ModeDecimal, expression: "2^-(10^20)", want: "0"},
+		{mode: ModeDecimal, expression: "0^-(10^20)", wantErr: ErrDivisionByZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode+" "+tt.expression, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+			service := NewCalculationService(mockRepo)
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", EvalOptions{Mode: tt.mode})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, result.Result)
+			}
+		})
+	}
+	assert.Equal(t, math.MaxInt, bitsToDigits(math.Inf(1)))
+	assert.Equal(t, math.MaxInt, bitsToDigits(1e30))
+}
@@ -0,0 +1,199 @@
+package calculationService
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ключи сортировки истории.
+const (
+	SortCreatedAt = "created_at" // по времени создания
+	SortResult    = "result"     // по числовому значению результата
+	SortDeletedAt = "deleted_at" // по времени удаления; только для корзины
+)
+
+// Направления сортировки.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Значения фильтра Filter.Status.
+const (
+	StatusSuccess = "success" // у задачи есть результат
+	StatusError   = "error"   // результата нет: записи старых версий, сохранявших неудачные задачи
+)
+
+const (
+	// DefaultPageSize — размер страницы, если он не задан.
+	DefaultPageSize = 50
+	// MaxPageSize — наибольший размер страницы.
+	MaxPageSize = 200
+)
+
+var (
+	// ErrInvalidCursor — курсор повреждён или получен с другой сортировкой.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidListOptions — неизвестная сортировка, направление, статус или размер страницы вне 1..MaxPageSize.
+	ErrInvalidListOptions = errors.New("invalid list options")
+)
+
+// Filter — условия отбора записей истории. Пустое поле не ограничивает выборку.
+type Filter struct {
+	Expression string     // подстрока выражения без учёта регистра
+	ResultMin  *float64   // нижняя граница результата, включительно
+	ResultMax  *float64   // верхняя граница результата, включительно
+	Status     string     // StatusSuccess или StatusError
+	From       *time.Time // создана не раньше, включительно
+	To         *time.Time // создана раньше, не включительно
+}
+
+// ListOptions — параметры запроса страницы истории.
+type ListOptions struct {
+	Limit  int    // размер страницы; 0 — DefaultPageSize
+	Cursor string // NextCursor предыдущей страницы; пустой — первая страница
+	Sort   string // SortCreatedAt (по умолчанию) или SortResult
+	Order  string // OrderDesc (по умолчанию) или OrderAsc
+	Filter Filter
+}
+
+// Page — страница истории. NextCursor пуст на последней странице.
+type Page struct {
+	Calculations []Calculation
+	NextCursor   string
+}
+
+// ListQuery — запрос страницы к репозиторию: курсор уже разобран,
+// Limit — сколько записей вернуть.
+type ListQuery struct {
+	Trash  bool // записи из корзины вместо обычных
+	Filter Filter
+	Sort   string
+	Order  string
+	After  *Cursor // вернуть записи строго после этой; nil — с начала
+	Limit  int
+}
+
+// Cursor — позиция в отсортированной истории: ключ сортировки и ID
+// последней записи страницы. Клиент получает его закодированным (Encode).
+type Cursor struct {
+	Sort  string    `json:"s"`
+	Order string    `json:"o"`
+	Time  time.Time `json:"t,omitempty"` // для SortCreatedAt и SortDeletedAt
+	Value *float64  `json:"v,omitempty"` // для SortResult; nil — у записи нет числового результата
+	ID    string    `json:"id"`
+}
+
+// Encode — непрозрачная строка курсора для ответа API.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor — разбирает строку курсора.
+func decodeCursor(s string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// query — проверяет параметры и превращает их в запрос к репозиторию.
+// Репозиторий получает на одну запись больше страницы, чтобы узнать,
+// есть ли следующая. Корзина всегда сортируется по времени удаления.
+func (o ListOptions) query(trash bool) (ListQuery, error) {
+	q := ListQuery{Trash: trash, Filter: o.Filter, Sort: o.Sort, Order: o.Order, Limit: o.Limit}
+	if q.Sort == "" {
+		q.Sort = SortCreatedAt
+	}
+	if trash {
+		q.Sort = SortDeletedAt
+	}
+	if q.Order == "" {
+		q.Order = OrderDesc
+	}
+	if q.Limit == 0 {
+		q.Limit = DefaultPageSize
+	}
+
+	if !trash && q.Sort != SortCreatedAt && q.Sort != SortResult {
+		return ListQuery{}, fmt.Errorf("%w: unknown sort %q", ErrInvalidListOptions, q.Sort)
+	}
+	if q.Order != OrderAsc && q.Order != OrderDesc {
+		return ListQuery{}, fmt.Errorf("%w: unknown order %q", ErrInvalidListOptions, q.Order)
+	}
+	if q.Limit < 1 || q.Limit > MaxPageSize {
+		return ListQuery{}, fmt.Errorf("%w: limit %d (allowed 1..%d)", ErrInvalidListOptions, q.Limit, MaxPageSize)
+	}
+	if s := q.Filter.Status; s != "" && s != StatusSuccess && s != StatusError {
+		return ListQuery{}, fmt.Errorf("%w: unknown status %q", ErrInvalidListOptions, s)
+	}
+
+	if o.Cursor != "" {
+		after, err := decodeCursor(o.Cursor)
+		if err != nil {
+			return ListQuery{}, err
+		}
+		if after.Sort != q.Sort || after.Order != q.Order {
+			return ListQuery{}, fmt.Errorf("%w: it was issued for sort %s %s", ErrInvalidCursor, after.Sort, after.Order)
+		}
+		q.After = after
+	}
+
+	q.Limit++
+	return q, nil
+}
+
+// page — страница из записей, полученных по q.
+func (q ListQuery) page(calcs []Calculation) Page {
+	if len(calcs) < q.Limit {
+		return Page{Calculations: calcs}
+	}
+	calcs = calcs[:q.Limit-1]
+	last := calcs[len(calcs)-1]
+	next := Cursor{Sort: q.Sort, Order: q.Order, ID: last.ID}
+	switch q.Sort {
+	case SortResult:
+		next.Value = last.ResultValue
+	case SortDeletedAt:
+		next.Time = last.DeletedAt.Time
+	default:
+		next.Time = last.CreatedAt
+	}
+	return Page{Calculations: calcs, NextCursor: next.Encode()}
+}
+
+// resultValue — числовое значение результата для сортировки и фильтра по
+// диапазону: "4", "1.5e+21", дробь "1/3". Для бесконечности, NaN, логических
+// и слишком больших для float64 результатов — nil.
+func resultValue(result string) *float64 {
+	var f float64
+	if strings.Contains(result, "/") {
+		r, ok := new(big.Rat).SetString(result)
+		if !ok {
+			return nil
+		}
+		f, _ = r.Float64()
+	} else {
+		var err error
+		if f, err = strconv.ParseFloat(result, 64); err != nil && !errors.Is(err, strconv.ErrRange) {
+			return nil
+		}
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil
+	}
+	return &f
+}
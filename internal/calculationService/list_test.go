@@ -0,0 +1,182 @@
+package calculationService
+
+import (
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func floatPtr(f float64) *float64 { return &f }
+
+func TestListCalculationsForUser(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	calcs := []Calculation{
+		{ID: "c", Expression: "3", Result: "3", UserID: "alice", CreatedAt: created.Add(2 * time.Minute)},
+		{ID: "b", Expression: "2", Result: "2", UserID: "alice", CreatedAt: created.Add(time.Minute)},
+		{ID: "a", Expression: "1", Result: "1", UserID: "alice", CreatedAt: created},
+	}
+
+	mockRepo := new(MockTaskRepository)
+	first := ListQuery{Sort: SortCreatedAt, Order: OrderDesc, Limit: 3}
+	mockRepo.On("ListCalculationsForUser", mock.Anything, first, "alice").Return(calcs, nil)
+
+	service := NewCalculationService(mockRepo)
+	page, err := service.ListCalculationsForUser(t.Context(), Requester{UserID: "alice"}, ListOptions{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, calcs[:2], page.Calculations)
+	require.NotEmpty(t, page.NextCursor)
+
+	// Следующая страница начинается после последней записи первой.
+	second := ListQuery{Sort: SortCreatedAt, Order: OrderDesc, Limit: 3, After: &Cursor{Sort: SortCreatedAt, Order: OrderDesc, Time: calcs[1].CreatedAt, ID: "b"}}
+	mockRepo.On("ListCalculationsForUser", mock.Anything, second, "alice").Return(calcs[2:], nil)
+
+	page, err = service.ListCalculationsForUser(t.Context(), Requester{UserID: "alice"}, ListOptions{Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, calcs[2:], page.Calculations)
+	assert.Empty(t, page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListCalculationsAdminSeesAll(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	q := ListQuery{Sort: SortResult, Order: OrderAsc, Limit: DefaultPageSize + 1, Filter: Filter{Status: StatusSuccess}}
+	mockRepo.On("ListCalculations", mock.Anything, q).Return([]Calculation{}, nil)
+
+	service := NewCalculationService(mockRepo)
+	_, err := service.ListCalculationsForUser(t.Context(), Requester{Admin: true}, ListOptions{Sort: SortResult, Order: OrderAsc, Filter: Filter{Status: StatusSuccess}})
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListOptionsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ListOptions
+		wantErr error
+	}{
+		{"неизвестная сортировка", ListOptions{Sort: "expression"}, ErrInvalidListOptions},
+		{"неизвестное направление", ListOptions{Order: "up"}, ErrInvalidListOptions},
+		{"слишком большая страница", ListOptions{Limit: MaxPageSize + 1}, ErrInvalidListOptions},
+		{"отрицательный размер", ListOptions{Limit: -1}, ErrInvalidListOptions},
+		{"неизвестный статус", ListOptions{Filter: Filter{Status: "pending"}}, ErrInvalidListOptions},
+		{"испорченный курсор", ListOptions{Cursor: "!!!"}, ErrInvalidCursor},
+		{"курсор другой сортировки", ListOptions{Sort: SortResult, Cursor: Cursor{Sort: SortCreatedAt, Order: OrderDesc, ID: "a"}.Encode()}, ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewCalculationService(new(MockTaskRepository))
+			_, err := service.ListCalculationsForUser(t.Context(), Requester{UserID: "alice"}, tt.opts)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestResultValue(t *testing.T) {
+	tests := []struct {
+		result string
+		want   *float64
+	}{
+		{"4", floatPtr(4)},
+		{"-1.5e+21", floatPtr(-1.5e21)},
+		{"1/4", floatPtr(0.25)},
+		{"1e-400", floatPtr(0)},
+		{"+Inf", nil},
+		{"NaN", nil},
+		{"true", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result, func(t *testing.T) {
+			assert.Equal(t, tt.want, resultValue(tt.result))
+		})
+	}
+}
+
+func TestListTrashForUser(t *testing.T) {
+	deleted := Calculation{ID: "a", Expression: "1", Result: "1", UserID: "alice", DeletedAt: gorm.DeletedAt{Time: testNow, Valid: true}}
+
+	mockRepo := new(MockTaskRepository)
+	// Сортировка и фильтры из opts в корзине не применяются.
+	q := ListQuery{Trash: true, Sort: SortDeletedAt, Order: OrderDesc, Limit: 2}
+	mockRepo.On("ListCalculationsForUser", mock.Anything, q, "alice").Return([]Calculation{deleted, deleted}, nil)
+
+	service := NewCalculationService(mockRepo)
+	page, err := service.ListTrashForUser(t.Context(), Requester{UserID: "alice"}, ListOptions{Limit: 1, Sort: SortResult, Filter: Filter{Expression: "x"}})
+	require.NoError(t, err)
+	assert.Equal(t, []Calculation{deleted}, page.Calculations)
+
+	after, err := decodeCursor(page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Sort: SortDeletedAt, Order: OrderDesc, Time: testNow, ID: "a"}, *after)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRestoreCalculationForUser(t *testing.T) {
+	restored := Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice"}
+
+	tests := []struct {
+		name      string
+		requester Requester
+		mockSetup func(m *MockTaskRepository)
+		wantErr   error
+	}{
+		{
+			name:      "владелец восстанавливает свою задачу",
+			requester: Requester{UserID: "alice"},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("RestoreCalculationForUser", mock.Anything, "1", "alice").Return(nil)
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(restored, nil)
+			},
+		},
+		{
+			name:      "администратор восстанавливает чужую задачу",
+			requester: Requester{UserID: "root", Admin: true},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("RestoreCalculation", mock.Anything, "1").Return(nil)
+				m.On("GetCalculationByID", mock.Anything, "1").Return(restored, nil)
+			},
+		},
+		{
+			name:      "чужая задача не найдена",
+			requester: Requester{UserID: "bob"},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("RestoreCalculationForUser", mock.Anything, "1", "bob").Return(gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrCalculationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			tt.mockSetup(mockRepo)
+
+			service := NewCalculationService(mockRepo)
+			calc, err := service.RestoreCalculationForUser(t.Context(), "1", tt.requester)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, restored, calc)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("PurgeDeleted", mock.Anything, testNow.Add(-24*time.Hour)).Return(int64(3), nil)
+
+	service := withClock(NewCalculationService(mockRepo))
+	n, err := service.PurgeTrash(t.Context(), 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,302 @@
+package calculationService
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Типы результата режима matrix (в дополнение к ResultNumber).
+const (
+	ResultVector = "vector" // вектор в JSON: "[17,39]"
+	ResultMatrix = "matrix" // матрица по строкам в JSON: "[[1,2],[3,4]]"
+)
+
+// mvalue — значение режима matrix: число, вектор или матрица.
+// Вектор в произведениях ведёт себя как столбец справа от матрицы
+// и как строка слева от неё.
+type mvalue struct {
+	rank int         // 0 — число, 1 — вектор, 2 — матрица
+	x    float64     // число
+	v    []float64   // вектор
+	m    [][]float64 // матрица по строкам; все строки одной длины
+}
+
+func scalar(x float64) mvalue       { return mvalue{x: x} }
+func vector(v []float64) mvalue     { return mvalue{rank: 1, v: v} }
+func matrixOf(m [][]float64) mvalue { return mvalue{rank: 2, m: m} }
+func (a mvalue) rows() int          { return len(a.m) }
+func (a mvalue) cols() int          { return len(a.m[0]) }
+func (a mvalue) square() bool       { return a.rank == 2 && a.rows() == a.cols() }
+func (a mvalue) isScalar() bool     { return a.rank == 0 }
+
+// shape — размер значения для сообщений об ошибках: "number", "vector[3]", "2x2".
+func (a mvalue) shape() string {
+	switch a.rank {
+	case 0:
+		return "number"
+	case 1:
+		return fmt.Sprintf("vector[%d]", len(a.v))
+	}
+	return fmt.Sprintf("%dx%d", a.rows(), a.cols())
+}
+
+// flat — все элементы значения по порядку.
+func (a mvalue) flat() []float64 {
+	switch a.rank {
+	case 0:
+		return []float64{a.x}
+	case 1:
+		return a.v
+	}
+	var all []float64
+	for _, row := range a.m {
+		all = append(all, row...)
+	}
+	return all
+}
+
+// mapValue — значение того же размера с элементами fn(x).
+func (a mvalue) mapValue(fn func(float64) float64) mvalue {
+	switch a.rank {
+	case 0:
+		return scalar(fn(a.x))
+	case 1:
+		v := make([]float64, len(a.v))
+		for i, x := range a.v {
+			v[i] = fn(x)
+		}
+		return vector(v)
+	}
+	m := make([][]float64, a.rows())
+	for i, row := range a.m {
+		m[i] = make([]float64, len(row))
+		for j, x := range row {
+			m[i][j] = fn(x)
+		}
+	}
+	return matrixOf(m)
+}
+
+// mismatch — ошибка размеров для операции op над a и b.
+func mismatch(a mvalue, op string, b mvalue) error {
+	return fmt.Errorf("%w: %s %s %s", ErrDimensionMismatch, a.shape(), op, b.shape())
+}
+
+// addValues — поэлементная сумма (sign = 1) или разность (sign = -1) значений одного размера.
+func addValues(a, b mvalue, sign float64) (mvalue, error) {
+	op := "+"
+	if sign < 0 {
+		op = "-"
+	}
+	if a.rank != b.rank {
+		return mvalue{}, mismatch(a, op, b)
+	}
+	switch a.rank {
+	case 0:
+		return scalar(a.x + sign*b.x), nil
+	case 1:
+		if len(a.v) != len(b.v) {
+			return mvalue{}, mismatch(a, op, b)
+		}
+		v := make([]float64, len(a.v))
+		for i := range v {
+			v[i] = a.v[i] + sign*b.v[i]
+		}
+		return vector(v), nil
+	}
+	if a.rows() != b.rows() || a.cols() != b.cols() {
+		return mvalue{}, mismatch(a, op, b)
+	}
+	m := make([][]float64, a.rows())
+	for i := range m {
+		m[i] = make([]float64, a.cols())
+		for j := range m[i] {
+			m[i][j] = a.m[i][j] + sign*b.m[i][j]
+		}
+	}
+	return matrixOf(m), nil
+}
+
+// mulValues — произведение: число на что угодно, матрица на матрицу,
+// матрица на вектор и вектор на матрицу. Произведение двух векторов
+// неоднозначно — для него есть dot и cross.
+func mulValues(a, b mvalue) (mvalue, error) {
+	switch {
+	case a.isScalar():
+		return b.mapValue(func(x float64) float64 { return a.x * x }), nil
+	case b.isScalar():
+		return a.mapValue(func(x float64) float64 { return x * b.x }), nil
+	case a.rank == 2 && b.rank == 2:
+		if a.cols() != b.rows() {
+			return mvalue{}, mismatch(a, "*", b)
+		}
+		m := make([][]float64, a.rows())
+		for i := range m {
+			m[i] = make([]float64, b.cols())
+			for j := range m[i] {
+				for k := 0; k < a.cols(); k++ {
+					m[i][j] += a.m[i][k] * b.m[k][j]
+				}
+			}
+		}
+		return matrixOf(m), nil
+	case a.rank == 2 && b.rank == 1:
+		if a.cols() != len(b.v) {
+			return mvalue{}, mismatch(a, "*", b)
+		}
+		v := make([]float64, a.rows())
+		for i, row := range a.m {
+			for k, x := range row {
+				v[i] += x * b.v[k]
+			}
+		}
+		return vector(v), nil
+	case a.rank == 1 && b.rank == 2:
+		if len(a.v) != b.rows() {
+			return mvalue{}, mismatch(a, "*", b)
+		}
+		v := make([]float64, b.cols())
+		for j := range v {
+			for k, x := range a.v {
+				v[j] += x * b.m[k][j]
+			}
+		}
+		return vector(v), nil
+	}
+	return mvalue{}, fmt.Errorf("%w: %s * %s is ambiguous, use dot or cross", ErrDimensionMismatch, a.shape(), b.shape())
+}
+
+// identity — единичная матрица n x n.
+func identity(n int) mvalue {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		m[i][i] = 1
+	}
+	return matrixOf(m)
+}
+
+// transpose — транспонированная матрица; вектор становится строкой 1 x n.
+func transpose(a mvalue) mvalue {
+	switch a.rank {
+	case 0:
+		return a
+	case 1:
+		return matrixOf([][]float64{append([]float64(nil), a.v...)})
+	}
+	m := make([][]float64, a.cols())
+	for j := range m {
+		m[j] = make([]float64, a.rows())
+		for i := range a.m {
+			m[j][i] = a.m[i][j]
+		}
+	}
+	return matrixOf(m)
+}
+
+// eliminate — приводит копию квадратной матрицы a к верхнетреугольному
+// виду методом Гаусса с выбором главного элемента; inverse, если не nil,
+// проходит те же преобразования (метод Гаусса — Жордана для inv).
+// Возвращает определитель.
+func eliminate(a mvalue, inverse [][]float64) float64 {
+	n := a.rows()
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = append([]float64(nil), a.m[i]...)
+	}
+	det := 1.0
+	for col := 0; col < n; col++ {
+		pivot := col
+		for i := col + 1; i < n; i++ {
+			if math.Abs(m[i][col]) > math.Abs(m[pivot][col]) {
+				pivot = i
+			}
+		}
+		if m[pivot][col] == 0 {
+			return 0
+		}
+		if pivot != col {
+			m[pivot], m[col] = m[col], m[pivot]
+			if inverse != nil {
+				inverse[pivot], inverse[col] = inverse[col], inverse[pivot]
+			}
+			det = -det
+		}
+		det *= m[col][col]
+		for i := 0; i < n; i++ {
+			if i == col || (inverse == nil && i < col) {
+				continue
+			}
+			f := m[i][col] / m[col][col]
+			for j := col; j < n; j++ {
+				m[i][j] -= f * m[col][j]
+			}
+			if inverse != nil {
+				for j := range inverse[i] {
+					inverse[i][j] -= f * inverse[col][j]
+				}
+			}
+		}
+	}
+	if inverse != nil {
+		for i := range inverse {
+			for j := range inverse[i] {
+				inverse[i][j] /= m[i][i]
+			}
+		}
+	}
+	return det
+}
+
+// invert — обратная матрица; у вырожденной матрицы её нет (ErrDomain).
+func invert(a mvalue) (mvalue, error) {
+	inverse := identity(a.rows()).m
+	if eliminate(a, inverse) == 0 {
+		return mvalue{}, fmt.Errorf("%w: matrix is singular", ErrDomain)
+	}
+	return matrixOf(inverse), nil
+}
+
+// formatValue — запись результата и его тип: число как в режиме units,
+// вектор и матрица — массивами JSON.
+func formatValue(a mvalue) (string, string) {
+	switch a.rank {
+	case 0:
+		return formatQuantity(a.x), ResultNumber
+	case 1:
+		return formatRow(a.v), ResultVector
+	}
+	rows := make([]string, len(a.m))
+	for i, row := range a.m {
+		rows[i] = formatRow(row)
+	}
+	return "[" + strings.Join(rows, ",") + "]", ResultMatrix
+}
+
+func formatRow(row []float64) string {
+	parts := make([]string, len(row))
+	for i, x := range row {
+		parts[i] = formatQuantity(x)
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// VectorElements — элементы результата типа ResultVector; false — результат не вектор.
+func VectorElements(result, resultType string) ([]float64, bool) {
+	var v []float64
+	if resultType != ResultVector || json.Unmarshal([]byte(result), &v) != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// MatrixElements — строки результата типа ResultMatrix; false — результат не матрица.
+func MatrixElements(result, resultType string) ([][]float64, bool) {
+	var m [][]float64
+	if resultType != ResultMatrix || json.Unmarshal([]byte(result), &m) != nil {
+		return nil, false
+	}
+	return m, true
+}
@@ -0,0 +1,301 @@
+package calculationService
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// MatrixEngine — имя движка векторов и матриц.
+const MatrixEngine = "matrix"
+
+// matrixEvaluator — движок линейной алгебры (float64): литералы векторов
+// [5, 6] и матриц по строкам [[1, 2], [3, 4]], сложение и вычитание
+// одинаковых по размеру значений, умножение на число, матриц друг на
+// друга и на вектор, целая степень квадратной матрицы и функции det, inv,
+// transpose, trace, dot, cross и norm. Несовпадение размеров —
+// ErrDimensionMismatch. Вектор и матрица записываются в результат
+// массивами JSON (ResultVector, ResultMatrix).
+type matrixEvaluator struct{}
+
+// NewMatrixEvaluator — создаёт движок векторов и матриц.
+func NewMatrixEvaluator() Evaluator {
+	return matrixEvaluator{}
+}
+
+func (matrixEvaluator) Name() string { return MatrixEngine }
+
+func (matrixEvaluator) Capabilities() Capabilities {
+	return Capabilities{
+		Description: "Vectors and matrices (float64): [5, 6] and [[1, 2], [3, 4]] literals, matrix products, integer powers, det/inv/transpose/trace/dot/cross/norm, dimension checking",
+		Modes:       []string{ModeMatrix},
+		Variables:   true,
+		Functions:   true,
+	}
+}
+
+func (matrixEvaluator) Parse(expression string, env Env) (Program, error) {
+	root, err := parseMatrixExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if err := env.Limits.checkTree(expression, root); err != nil {
+		return nil, err
+	}
+	return &astProgram{source: expression, root: root}, nil
+}
+
+func (matrixEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	p, ok := program.(*astProgram)
+	if !ok {
+		return Evaluation{}, fmt.Errorf("matrix: foreign program %T", program)
+	}
+	env.ctx = ctx
+
+	a, err := evalMatrix(p.root, env)
+	if err != nil {
+		return Evaluation{}, err
+	}
+	for _, x := range a.flat() {
+		switch {
+		case math.IsNaN(x):
+			return Evaluation{}, fmt.Errorf("%w: result is not a number", ErrDomain)
+		case math.IsInf(x, 0):
+			return Evaluation{}, fmt.Errorf("%w: result is infinite", ErrOverflow)
+		}
+	}
+	result, typ := formatValue(a)
+	return Evaluation{Result: result, Type: typ}, nil
+}
+
+// evalMatrix — вычисляет дерево в числах, векторах и матрицах.
+func evalMatrix(n node, env Env) (mvalue, error) {
+	if err := env.interrupted(); err != nil {
+		return mvalue{}, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		x, err := strconv.ParseFloat(n.text, 64)
+		if err != nil {
+			return mvalue{}, &SyntaxError{Offset: n.pos, Token: n.text, Message: "malformed number"}
+		}
+		return scalar(x), nil
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			return mvalue{}, unsupportedNode(n)
+		}
+		x, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return mvalue{}, fmt.Errorf("variable %q has malformed value %q", n.name, value)
+		}
+		return scalar(x), nil
+	case *arrayNode:
+		elems := make([]mvalue, len(n.elems))
+		for i, elem := range n.elems {
+			a, err := evalMatrix(elem, env)
+			if err != nil {
+				return mvalue{}, err
+			}
+			elems[i] = a
+		}
+		return stack(elems)
+	case *callNode:
+		args := make([]mvalue, len(n.args))
+		for i, arg := range n.args {
+			a, err := evalMatrix(arg, env)
+			if err != nil {
+				return mvalue{}, err
+			}
+			args[i] = a
+		}
+		if f, ok := matrixFunctions[n.name]; ok {
+			if len(args) != f.args {
+				return mvalue{}, fmt.Errorf("%w: %s takes %d argument(s), got %d", ErrArity, n.name, f.args, len(args))
+			}
+			return f.fn(args)
+		}
+		f, ok := env.Functions[n.name]
+		if !ok {
+			return mvalue{}, unsupportedNode(n)
+		}
+		xs := make([]float64, len(args))
+		for i, a := range args {
+			if !a.isScalar() {
+				return mvalue{}, fmt.Errorf("%w: %s takes numbers, got %s", ErrDimensionMismatch, n.name, a.shape())
+			}
+			xs[i] = a.x
+		}
+		x, err := f.callFloat(env, xs)
+		return scalar(x), err
+	case *unaryNode:
+		a, err := evalMatrix(n.x, env)
+		if err != nil {
+			return mvalue{}, err
+		}
+		if n.op == "-" {
+			return a.mapValue(func(x float64) float64 { return -x }), nil
+		}
+		return a, nil
+	case *binaryNode:
+		a, err := evalMatrix(n.x, env)
+		if err != nil {
+			return mvalue{}, err
+		}
+		b, err := evalMatrix(n.y, env)
+		if err != nil {
+			return mvalue{}, err
+		}
+		switch n.op {
+		case "+":
+			return addValues(a, b, 1)
+		case "-":
+			return addValues(a, b, -1)
+		case "*":
+			return mulValues(a, b)
+		case "/":
+			if !b.isScalar() {
+				return mvalue{}, fmt.Errorf("%w: %s / %s, multiply by inv() instead", ErrDimensionMismatch, a.shape(), b.shape())
+			}
+			if b.x == 0 {
+				return mvalue{}, ErrDivisionByZero
+			}
+			return a.mapValue(func(x float64) float64 { return x / b.x }), nil
+		case "%":
+			if !a.isScalar() || !b.isScalar() {
+				return mvalue{}, mismatch(a, "%", b)
+			}
+			if b.x == 0 {
+				return mvalue{}, ErrDivisionByZero
+			}
+			return scalar(math.Mod(a.x, b.x)), nil
+		case "^":
+			return powValue(a, b, env)
+		}
+	}
+	return mvalue{}, unsupportedNode(n)
+}
+
+// stack — вектор из чисел или матрица из векторов одной длины (строк).
+func stack(elems []mvalue) (mvalue, error) {
+	switch elems[0].rank {
+	case 0:
+		v := make([]float64, len(elems))
+		for i, a := range elems {
+			if !a.isScalar() {
+				return mvalue{}, fmt.Errorf("%w: vector element %d is %s", ErrDimensionMismatch, i+1, a.shape())
+			}
+			v[i] = a.x
+		}
+		return vector(v), nil
+	case 1:
+		m := make([][]float64, len(elems))
+		for i, a := range elems {
+			if a.rank != 1 || len(a.v) != len(elems[0].v) {
+				return mvalue{}, fmt.Errorf("%w: matrix row %d is %s, row 1 is %s", ErrDimensionMismatch, i+1, a.shape(), elems[0].shape())
+			}
+			m[i] = a.v
+		}
+		return matrixOf(m), nil
+	}
+	return mvalue{}, fmt.Errorf("%w: matrix elements must be numbers, got %s", ErrDimensionMismatch, elems[0].shape())
+}
+
+// powValue — степень числа или целая степень квадратной матрицы
+// (отрицательная — степень обратной матрицы).
+func powValue(a, b mvalue, env Env) (mvalue, error) {
+	if !b.isScalar() {
+		return mvalue{}, mismatch(a, "^", b)
+	}
+	if a.isScalar() {
+		return scalar(math.Pow(a.x, b.x)), nil
+	}
+	if !a.square() {
+		return mvalue{}, fmt.Errorf("%w: power of %s, matrix must be square", ErrDimensionMismatch, a.shape())
+	}
+	if b.x != math.Trunc(b.x) || math.IsInf(b.x, 0) {
+		return mvalue{}, fmt.Errorf("%w: matrix power %v is not an integer", ErrDomain, b.x)
+	}
+	k := b.x
+	if k < 0 {
+		inverse, err := invert(a)
+		if err != nil {
+			return mvalue{}, err
+		}
+		a, k = inverse, -k
+	}
+	// возведение в квадрат: log2(k) умножений
+	result := identity(a.rows())
+	for ; k > 0; k = math.Floor(k / 2) {
+		if err := env.interrupted(); err != nil {
+			return mvalue{}, err
+		}
+		if math.Mod(k, 2) == 1 {
+			result, _ = mulValues(result, a)
+		}
+		a, _ = mulValues(a, a)
+	}
+	return result, nil
+}
+
+// matrixFunction — функция режима matrix над числами, векторами и матрицами.
+type matrixFunction struct {
+	args int
+	fn   func(args []mvalue) (mvalue, error)
+}
+
+// matrixFunctions — функции линейной алгебры. Они важнее встроенных функций
+// и функций пользователя с теми же именами.
+var matrixFunctions = map[string]matrixFunction{
+	"det": {1, func(a []mvalue) (mvalue, error) {
+		if !a[0].square() {
+			return mvalue{}, fmt.Errorf("%w: det of %s, matrix must be square", ErrDimensionMismatch, a[0].shape())
+		}
+		return scalar(eliminate(a[0], nil)), nil
+	}},
+	"inv": {1, func(a []mvalue) (mvalue, error) {
+		if !a[0].square() {
+			return mvalue{}, fmt.Errorf("%w: inv of %s, matrix must be square", ErrDimensionMismatch, a[0].shape())
+		}
+		return invert(a[0])
+	}},
+	"transpose": {1, func(a []mvalue) (mvalue, error) {
+		return transpose(a[0]), nil
+	}},
+	"trace": {1, func(a []mvalue) (mvalue, error) {
+		if !a[0].square() {
+			return mvalue{}, fmt.Errorf("%w: trace of %s, matrix must be square", ErrDimensionMismatch, a[0].shape())
+		}
+		var sum float64
+		for i, row := range a[0].m {
+			sum += row[i]
+		}
+		return scalar(sum), nil
+	}},
+	"dot": {2, func(a []mvalue) (mvalue, error) {
+		if a[0].rank != 1 || a[1].rank != 1 || len(a[0].v) != len(a[1].v) {
+			return mvalue{}, mismatch(a[0], "dot", a[1])
+		}
+		var sum float64
+		for i, x := range a[0].v {
+			sum += x * a[1].v[i]
+		}
+		return scalar(sum), nil
+	}},
+	"cross": {2, func(a []mvalue) (mvalue, error) {
+		if a[0].rank != 1 || a[1].rank != 1 || len(a[0].v) != 3 || len(a[1].v) != 3 {
+			return mvalue{}, fmt.Errorf("%w: cross of %s and %s, vectors must have 3 elements", ErrDimensionMismatch, a[0].shape(), a[1].shape())
+		}
+		u, v := a[0].v, a[1].v
+		return vector([]float64{u[1]*v[2] - u[2]*v[1], u[2]*v[0] - u[0]*v[2], u[0]*v[1] - u[1]*v[0]}), nil
+	}},
+	"norm": {1, func(a []mvalue) (mvalue, error) {
+		// длина вектора, норма Фробениуса матрицы, модуль числа
+		var sum float64
+		for _, x := range a[0].flat() {
+			sum += x * x
+		}
+		return scalar(math.Sqrt(sum)), nil
+	}},
+}
@@ -0,0 +1,85 @@
+package calculationService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMatrix(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		wantType   string
+		wantErr    error
+	}{
+		{name: "матрица на вектор", expression: "[[1,2],[3,4]] * [5,6]", want: "[17,39]", wantType: ResultVector},
+		{name: "определитель", expression: "det([[1,2],[3,4]])", want: "-2", wantType: ResultNumber},
+		{name: "обратная матрица", expression: "inv([[1,2],[3,4]])", want: "[[-2,1],[1.5,-0.5]]", wantType: ResultMatrix},
+		{name: "транспонирование", expression: "transpose([[1,2],[3,4]])", want: "[[1,3],[2,4]]", wantType: ResultMatrix},
+		{name: "скалярное произведение", expression: "dot([1,2,3], [4,5,6])", want: "32", wantType: ResultNumber},
+		{name: "векторное произведение", expression: "cross([1,0,0], [0,1,0])", want: "[0,0,1]", wantType: ResultVector},
+		{name: "произведение матриц", expression: "[[1,2],[3,4]] * [[0,1],[1,0]]", want: "[[2,1],[4,3]]", wantType: ResultMatrix},
+		{name: "вектор на матрицу", expression: "[1,1] * [[1,2],[3,4]]", want: "[4,6]", wantType: ResultVector},
+		{name: "степень матрицы", expression: "[[1,1],[0,1]]^3", want: "[[1,3],[0,1]]", wantType: ResultMatrix},
+		{name: "отрицательная степень", expression: "[[2,0],[0,4]]^-1", want: "[[0.5,0],[0,0.25]]", wantType: ResultMatrix},
+		{name: "линейная комбинация", expression: "2 * [1,2] - [1,1] / 2", want: "[1.5,3.5]", wantType: ResultVector},
+		{name: "выражения в элементах", expression: "[sqrt(16), 2^3]", want: "[4,8]", wantType: ResultVector},
+		{name: "длина и след", expression: "norm([3,4]) + trace([[1,2],[3,4]])", want: "10", wantType: ResultNumber},
+		{name: "векторы разной длины", expression: "[1,2] + [1,2,3]", wantErr: ErrDimensionMismatch},
+		{name: "строки разной длины", expression: "[[1,2],[3]]", wantErr: ErrDimensionMismatch},
+		{name: "несогласованное произведение", expression: "[[1,2],[3,4]] * [1,2,3]", wantErr: ErrDimensionMismatch},
+		{name: "произведение векторов", expression: "[1,2] * [3,4]", wantErr: ErrDimensionMismatch},
+		{name: "определитель не квадратной", expression: "det([[1,2,3],[4,5,6]])", wantErr: ErrDimensionMismatch},
+		{name: "функция чисел от вектора", expression: "sin([1,2])", wantErr: ErrDimensionMismatch},
+		{name: "вырожденная матрица", expression: "inv([[1,2],[2,4]])", wantErr: ErrDomain},
+		{name: "деление на ноль", expression: "[1,2] / 0", wantErr: ErrDivisionByZero},
+		{name: "число аргументов", expression: "dot([1,2])", wantErr: ErrArity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service := NewCalculationService(mockRepo)
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", EvalOptions{Mode: ModeMatrix})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, MatrixEngine, result.Engine)
+				assert.Equal(t, tt.want, result.Result)
+				assert.Equal(t, tt.wantType, result.ResultType)
+			}
+		})
+	}
+}
+
+func TestMatrixLiteralsOnlyInMatrixMode(t *testing.T) {
+	_, err := parseExpression("[1, 2]")
+	var syntaxErr *SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+
+	_, err = parseMatrixExpression("[]")
+	assert.ErrorAs(t, err, &syntaxErr)
+}
+
+func TestMatrixElements(t *testing.T) {
+	v, ok := VectorElements("[17,39]", ResultVector)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{17, 39}, v)
+
+	m, ok := MatrixElements("[[1,2],[3.5,-4]]", ResultMatrix)
+	assert.True(t, ok)
+	assert.Equal(t, [][]float64{{1, 2}, {3.5, -4}}, m)
+
+	_, ok = MatrixElements("[17,39]", ResultVector)
+	assert.False(t, ok, "тип результата не матрица")
+	_, ok = VectorElements("4", ResultNumber)
+	assert.False(t, ok)
+}
@@ -0,0 +1,389 @@
+package calculationService
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// memoryRepository — CalculationRepository в памяти процесса с той же
+// семантикой, что и у calcRepository: порядок, ошибки gorm.ErrRecordNotFound
+// и gorm.ErrDuplicatedKey, версии, корзина и ревизии. Данные теряются при
+// остановке; годится для демонстраций и тестов.
+type memoryRepository struct {
+	mu           sync.RWMutex
+	calculations map[string]Calculation
+	revisions    map[string][]Revision // по ID записи, по возрастанию номера
+}
+
+// NewMemoryRepository — пустой репозиторий в памяти.
+func NewMemoryRepository() CalculationRepository {
+	return &memoryRepository{
+		calculations: make(map[string]Calculation),
+		revisions:    make(map[string][]Revision),
+	}
+}
+
+// CreateCalculation — добавляет запись и её первую ревизию. Пустые версия
+// и время получают значения по умолчанию, как в БД.
+func (r *memoryRepository) CreateCalculation(ctx context.Context, calc Calculation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.calculations[calc.ID]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	if calc.Version == 0 {
+		calc.Version = 1
+	}
+	now := time.Now().UTC()
+	if calc.CreatedAt.IsZero() {
+		calc.CreatedAt = now
+	}
+	if calc.UpdatedAt.IsZero() {
+		calc.UpdatedAt = now
+	}
+	r.calculations[calc.ID] = calc.clone()
+	r.addRevision(calc)
+	return nil
+}
+
+// GetAllCalculations — все записи не из корзины по времени создания.
+func (r *memoryRepository) GetAllCalculations(ctx context.Context) ([]Calculation, error) {
+	return r.all(func(Calculation) bool { return true }), nil
+}
+
+// GetCalculationByID — запись по ID.
+func (r *memoryRepository) GetCalculationByID(ctx context.Context, id string) (Calculation, error) {
+	return r.get(id, "")
+}
+
+// UpdateCalculation — обновляет запись по ID из calc.
+func (r *memoryRepository) UpdateCalculation(ctx context.Context, calc Calculation) error {
+	return r.update(calc, "")
+}
+
+// DeleteCalculation — переносит запись в корзину.
+func (r *memoryRepository) DeleteCalculation(ctx context.Context, id string) error {
+	return r.delete(id, "", 0)
+}
+
+// DeleteCalculationIfVersion — как DeleteCalculation, но только для записи версии version.
+func (r *memoryRepository) DeleteCalculationIfVersion(ctx context.Context, id string, version int) error {
+	return r.delete(id, "", version)
+}
+
+// GetAllCalculationsForUser — записи одного пользователя.
+func (r *memoryRepository) GetAllCalculationsForUser(ctx context.Context, userID string) ([]Calculation, error) {
+	return r.all(func(calc Calculation) bool { return calc.UserID == userID }), nil
+}
+
+// GetCalculationByIDForUser — запись по ID среди записей пользователя.
+func (r *memoryRepository) GetCalculationByIDForUser(ctx context.Context, id, userID string) (Calculation, error) {
+	return r.get(id, userID)
+}
+
+// UpdateCalculationForUser — как UpdateCalculation, но только для записи пользователя.
+func (r *memoryRepository) UpdateCalculationForUser(ctx context.Context, calc Calculation, userID string) error {
+	return r.update(calc, userID)
+}
+
+// DeleteCalculationForUser — как DeleteCalculation, но только для записи пользователя.
+func (r *memoryRepository) DeleteCalculationForUser(ctx context.Context, id, userID string) error {
+	return r.delete(id, userID, 0)
+}
+
+// DeleteCalculationForUserIfVersion — как DeleteCalculationForUser, но только для записи версии version.
+func (r *memoryRepository) DeleteCalculationForUserIfVersion(ctx context.Context, id, userID string, version int) error {
+	return r.delete(id, userID, version)
+}
+
+// ListCalculations — страница записей всех пользователей.
+func (r *memoryRepository) ListCalculations(ctx context.Context, q ListQuery) ([]Calculation, error) {
+	return r.list(q, ""), nil
+}
+
+// ListCalculationsForUser — страница записей одного пользователя.
+func (r *memoryRepository) ListCalculationsForUser(ctx context.Context, q ListQuery, userID string) ([]Calculation, error) {
+	return r.list(q, userID), nil
+}
+
+// RestoreCalculation — возвращает запись из корзины.
+func (r *memoryRepository) RestoreCalculation(ctx context.Context, id string) error {
+	return r.restore(id, "")
+}
+
+// RestoreCalculationForUser — как RestoreCalculation, но только для записи пользователя.
+func (r *memoryRepository) RestoreCalculationForUser(ctx context.Context, id, userID string) error {
+	return r.restore(id, userID)
+}
+
+// PurgeDeleted — окончательно удаляет записи, попавшие в корзину раньше
+// before, и их ревизии.
+func (r *memoryRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var purged int64
+	for id, calc := range r.calculations {
+		if calc.DeletedAt.Valid && calc.DeletedAt.Time.Before(before) {
+			delete(r.calculations, id)
+			delete(r.revisions, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// GetRevisions — ревизии записи по возрастанию номера.
+func (r *memoryRepository) GetRevisions(ctx context.Context, calculationID string) ([]Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	revisions := make([]Revision, 0, len(r.revisions[calculationID]))
+	for _, rev := range r.revisions[calculationID] {
+		revisions = append(revisions, rev.clone())
+	}
+	return revisions, nil
+}
+
+// GetRevision — ревизия записи с номером number.
+func (r *memoryRepository) GetRevision(ctx context.Context, calculationID string, number int) (Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, rev := range r.revisions[calculationID] {
+		if rev.Number == number {
+			return rev.clone(), nil
+		}
+	}
+	return Revision{}, gorm.ErrRecordNotFound
+}
+
+// find — запись id (пользователя userID, если он не пуст); запись из
+// корзины — только при trashed. Вызывается под блокировкой.
+func (r *memoryRepository) find(id, userID string, trashed bool) (Calculation, bool) {
+	calc, ok := r.calculations[id]
+	if !ok || (userID != "" && calc.UserID != userID) || (calc.DeletedAt.Valid && !trashed) {
+		return Calculation{}, false
+	}
+	return calc, true
+}
+
+// get — копия записи id не из корзины.
+func (r *memoryRepository) get(id, userID string) (Calculation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	calc, ok := r.find(id, userID, false)
+	if !ok {
+		return Calculation{}, gorm.ErrRecordNotFound
+	}
+	return calc.clone(), nil
+}
+
+// all — копии записей не из корзины, отобранных match, по времени создания.
+func (r *memoryRepository) all(match func(Calculation) bool) []Calculation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var calculations []Calculation
+	for _, calc := range r.calculations {
+		if !calc.DeletedAt.Valid && match(calc) {
+			calculations = append(calculations, calc.clone())
+		}
+	}
+	sort.Slice(calculations, func(i, j int) bool {
+		a, b := calculations[i], calculations[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+	return calculations
+}
+
+// update — как calcRepository.update: проверка версии, ревизия с прежним
+// состоянием для записи без ревизий и ревизия с новым.
+func (r *memoryRepository) update(calc Calculation, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current, ok := r.find(calc.ID, userID, false)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	if current.Version != calc.Version {
+		return ErrVersionMismatch
+	}
+	if len(r.revisions[current.ID]) == 0 {
+		if current.UpdatedBy == "" {
+			current.UpdatedBy = current.UserID
+		}
+		r.addRevision(current)
+	}
+
+	current.Expression = calc.Expression
+	current.Result = calc.Result
+	current.Unit = calc.Unit
+	current.ResultType = calc.ResultType
+	current.ResultValue = calc.ResultValue
+	current.Engine = calc.Engine
+	current.Mode = calc.Mode
+	current.Precision = calc.Precision
+	current.WordType = calc.WordType
+	current.ComplexForm = calc.ComplexForm
+	current.AngleUnit = calc.AngleUnit
+	current.Variables = calc.Variables
+	current.Functions = calc.Functions
+	current.Rates = calc.Rates
+	current.UpdatedAt = calc.UpdatedAt
+	current.UpdatedBy = calc.UpdatedBy
+	current.Version++
+	r.calculations[current.ID] = current.clone()
+	r.addRevision(calc)
+	return nil
+}
+
+// addRevision — сохраняет снимок calc под следующим номером. Вызывается
+// под блокировкой.
+func (r *memoryRepository) addRevision(calc Calculation) {
+	revisions := r.revisions[calc.ID]
+	number := 1
+	if len(revisions) > 0 {
+		number = revisions[len(revisions)-1].Number + 1
+	}
+	rev := newRevision(calc, number)
+	if rev.CreatedAt.IsZero() {
+		rev.CreatedAt = time.Now().UTC()
+	}
+	r.revisions[calc.ID] = append(revisions, rev.clone())
+}
+
+// delete — переносит запись в корзину, если её версия равна version;
+// version 0 — любой версии.
+func (r *memoryRepository) delete(id, userID string, version int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calc, ok := r.find(id, userID, false)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	if version != 0 && calc.Version != version {
+		return ErrVersionMismatch
+	}
+	calc.DeletedAt = gorm.DeletedAt{Time: time.Now().UTC(), Valid: true}
+	r.calculations[id] = calc
+	return nil
+}
+
+// restore — снимает с записи отметку об удалении.
+func (r *memoryRepository) restore(id, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calc, ok := r.find(id, userID, true)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	calc.DeletedAt = gorm.DeletedAt{}
+	r.calculations[id] = calc
+	return nil
+}
+
+// list — как calcRepository.list: записи, отобранные q.Filter, в порядке
+// q.Sort и q.Order, после курсора q.After; не больше q.Limit.
+func (r *memoryRepository) list(q ListQuery, userID string) []Calculation {
+	r.mu.RLock()
+	var calculations []Calculation
+	for _, calc := range r.calculations {
+		if calc.DeletedAt.Valid == q.Trash && (userID == "" || calc.UserID == userID) && q.Filter.match(calc) {
+			calculations = append(calculations, calc.clone())
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(calculations, func(i, j int) bool { return q.before(calculations[i], calculations[j]) })
+	if after := q.After; after != nil {
+		cursor := Calculation{ID: after.ID, ResultValue: after.Value, CreatedAt: after.Time, DeletedAt: gorm.DeletedAt{Time: after.Time, Valid: true}}
+		i := sort.Search(len(calculations), func(i int) bool { return q.before(cursor, calculations[i]) })
+		calculations = calculations[i:]
+	}
+	if len(calculations) > q.Limit {
+		calculations = calculations[:q.Limit]
+	}
+	return calculations
+}
+
+// before — a идёт раньше b в порядке q.Sort и q.Order, с ID вторым ключом.
+// Записи без числового результата при сортировке по результату — последние.
+func (q ListQuery) before(a, b Calculation) bool {
+	desc := q.Order == OrderDesc
+	switch q.Sort {
+	case SortResult:
+		switch {
+		case a.ResultValue == nil && b.ResultValue != nil:
+			return false
+		case a.ResultValue != nil && b.ResultValue == nil:
+			return true
+		case a.ResultValue != nil && *a.ResultValue != *b.ResultValue:
+			return (*a.ResultValue < *b.ResultValue) != desc
+		}
+	default:
+		at, bt := a.CreatedAt, b.CreatedAt
+		if q.Sort == SortDeletedAt {
+			at, bt = a.DeletedAt.Time, b.DeletedAt.Time
+		}
+		if !at.Equal(bt) {
+			return at.Before(bt) != desc
+		}
+	}
+	return a.ID != b.ID && (a.ID < b.ID) != desc
+}
+
+// match — запись удовлетворяет всем условиям фильтра.
+func (f Filter) match(calc Calculation) bool {
+	if f.Expression != "" && !strings.Contains(strings.ToLower(calc.Expression), strings.ToLower(f.Expression)) {
+		return false
+	}
+	if f.ResultMin != nil && (calc.ResultValue == nil || *calc.ResultValue < *f.ResultMin) {
+		return false
+	}
+	if f.ResultMax != nil && (calc.ResultValue == nil || *calc.ResultValue > *f.ResultMax) {
+		return false
+	}
+	switch f.Status {
+	case StatusSuccess:
+		if calc.Result == "" {
+			return false
+		}
+	case StatusError:
+		if calc.Result != "" {
+			return false
+		}
+	}
+	if f.From != nil && calc.CreatedAt.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && !calc.CreatedAt.Before(*f.To) {
+		return false
+	}
+	return true
+}
+
+// clone — копия записи, не разделяющая с ней изменяемые данные.
+func (c Calculation) clone() Calculation {
+	c.Variables = maps.Clone(c.Variables)
+	c.Functions = maps.Clone(c.Functions)
+	c.Rates = maps.Clone(c.Rates)
+	if c.ResultValue != nil {
+		v := *c.ResultValue
+		c.ResultValue = &v
+	}
+	return c
+}
+
+// clone — копия ревизии, не разделяющая с ней изменяемые данные.
+func (rev Revision) clone() Revision {
+	rev.Variables = maps.Clone(rev.Variables)
+	rev.Functions = maps.Clone(rev.Functions)
+	rev.Rates = maps.Clone(rev.Rates)
+	return rev
+}
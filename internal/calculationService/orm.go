@@ -1,16 +1,56 @@
 package calculationService
 
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
 // Calculation — основная модель для таблицы в базе данных.
 // Здесь хранятся выражение и его результат.
 type Calculation struct {
-	ID         string `gorm:"primaryKey" json:"id"` // Уникальный идентификатор записи
-	Expression string `json:"expression"`           // Выражение (например, "2+2")
-	Result     string `json:"result"`               // Результат вычисления (например, "4")
-	UserID     string `gorm:"index" json:"user_id"` // ID пользователя-владельца задачи
+	ID          string   `gorm:"primaryKey" json:"id"`                // Уникальный идентификатор записи
+	Expression  string   `gorm:"size:255;not null" json:"expression"` // Выражение (например, "2+2"); не длиннее MaxExpressionLength
+	Result      string   `json:"result"`                              // Результат вычисления (например, "4")
+	Unit        string   `json:"unit,omitempty"`                      // Единица результата в режиме units (например, "km/h")
+	ResultType  string   `json:"result_type"`                         // Тип результата: number, complex, vector или matrix (см. ResultNumber)
+	Engine      string   `json:"engine"`                              // Движок, которым посчитан результат (например, "govaluate")
+	Mode        string   `json:"mode"`                                // Режим точности: float, rational или decimal
+	Precision   int      `json:"precision"`                           // Значащих цифр в режиме decimal (0 для других режимов)
+	WordType    string   `json:"word_type,omitempty"`                 // Тип слова в режиме programmer (например, "uint8")
+	ComplexForm string   `json:"complex_form,omitempty"`              // Запись результата в режиме complex: rectangular или polar
+	AngleUnit   string   `json:"angle_unit"`                          // Единицы углов тригонометрических функций: radians или degrees
+	UserID      string   `gorm:"index" json:"user_id"`                // ID пользователя-владельца задачи
+	UpdatedBy   string   `json:"updated_by"`                          // ID пользователя, создавшего или последним изменившего задачу
+	Variables   Bindings `json:"variables,omitempty"`                 // Значения переменных и констант, использованных в выражении
+	Functions   Bindings `json:"functions,omitempty"`                 // Определения вызванных функций пользователя: имя → "f(x) = ..."
+	Rates       Bindings `json:"rates,omitempty"`                     // Курсы использованных валют на момент вычисления: код → "0.92"
+
+	// ResultValue — числовое значение результата для сортировки и фильтров
+	// истории; nil, если результат не конечное число (см. resultValue).
+	ResultValue *float64 `gorm:"index" json:"-"`
+
+	// Version — номер версии записи: растёт на 1 при каждом изменении.
+	// Изменение применяется, только если версия в БД та же, что была
+	// прочитана (см. ErrVersionMismatch).
+	Version int `gorm:"not null;default:1" json:"version"`
+
+	// Время создания и последнего пересчёта. Удалённая запись попадает в
+	// корзину (DeletedAt != nil): GORM не возвращает её в обычных запросах,
+	// пока её не восстановят или не удалят окончательно (PurgeTrash).
+	CreatedAt time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP;index" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
 
 // CalculationRequest — структура для приёма данных от пользователя.
 // Используется, когда фронтенд отправляет JSON с выражением.
 type CalculationRequest struct {
-	Expression string `json:"expression"` // Входное выражение для вычисления
+	Expression  string `json:"expression"`             // Входное выражение для вычисления
+	Engine      string `json:"engine,omitempty"`       // Движок вычислений; пусто — по умолчанию
+	Mode        string `json:"mode,omitempty"`         // Режим точности; пусто — режим движка
+	Precision   int    `json:"precision,omitempty"`    // Значащих цифр для режима decimal
+	WordType    string `json:"word_type,omitempty"`    // Тип слова для режима programmer: int8..uint64 (по умолчанию int64)
+	ComplexForm string `json:"complex_form,omitempty"` // Запись результата режима complex: rectangular (по умолчанию) или polar
+	AngleUnit   string `json:"angle_unit,omitempty"`   // Единицы углов: radians (по умолчанию) или degrees
 }
@@ -0,0 +1,600 @@
+package calculationService
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Собственный разборщик выражений. Его используют движки, которым мало
+// float64 из govaluate: они получают дерево (AST) и сами решают, в каких
+// числах его вычислять.
+
+// SyntaxError — ошибка разбора выражения с позицией в исходной строке.
+type SyntaxError struct {
+	Offset  int    // смещение (в символах) от начала выражения; -1 — неизвестно
+	Token   string // токен, на котором споткнулся разбор ("" — конец строки)
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Offset < 0 {
+		return "syntax error: " + e.Message
+	}
+	if e.Token == "" {
+		return fmt.Sprintf("syntax error at offset %d: %s", e.Offset, e.Message)
+	}
+	return fmt.Sprintf("syntax error at offset %d near %q: %s", e.Offset, e.Token, e.Message)
+}
+
+// NameError — неизвестная переменная или функция с позицией в выражении.
+// Сравнивается с ErrUnknownVariable и ErrUnknownFunction через errors.Is.
+type NameError struct {
+	Err    error  // ErrUnknownVariable или ErrUnknownFunction
+	Name   string // имя, которое не удалось найти
+	Offset int    // смещение (в символах) от начала выражения; -1 — неизвестно
+}
+
+func (e *NameError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("%v %q", e.Err, e.Name)
+	}
+	return fmt.Sprintf("%v %q at offset %d", e.Err, e.Name, e.Offset)
+}
+
+func (e *NameError) Unwrap() error { return e.Err }
+
+// nameOffset — позиция первого вхождения имени в выражение или -1.
+func nameOffset(expression, name string) int {
+	tokens, err := lex(expression)
+	if err != nil {
+		return -1
+	}
+	for _, tok := range tokens {
+		if tok.kind == tokIdent && tok.text == name {
+			return tok.pos
+		}
+	}
+	return -1
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+	tokLBracket
+	tokRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int // смещение в символах (рунах)
+}
+
+// lex — разбивает выражение на токены.
+func lex(expression string) ([]token, error) {
+	runes := []rune(expression)
+	var tokens []token
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '0' && i+2 < len(runes) && strings.ContainsRune(baseDigits[unicode.ToLower(runes[i+1])], runes[i+2]):
+			// целое с основанием: 0x1F, 0b1010, 0o17
+			start, digits := i, baseDigits[unicode.ToLower(runes[i+1])]
+			for i += 2; i < len(runes) && strings.ContainsRune(digits, runes[i]); i++ {
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: string(runes[start:i]), pos: start})
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			// экспонента: 1e10, 2.5E-3
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for j < len(runes) && unicode.IsDigit(runes[j]) {
+						j++
+					}
+					i = j
+				}
+			}
+			// мнимое число: 4i, 2.5e-3i (понимает только режим complex)
+			if i < len(runes) && runes[i] == 'i' && (i+1 == len(runes) || !isAlnum(runes[i+1])) {
+				i++
+			}
+			text := string(runes[start:i])
+			if strings.Count(text, ".") > 1 {
+				return nil, &SyntaxError{Offset: start, Token: text, Message: "malformed number"}
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: text, pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && isAlnum(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: string(runes[start:i]), pos: start})
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case r == '[':
+			tokens = append(tokens, token{kind: tokLBracket, text: "[", pos: i})
+			i++
+		case r == ']':
+			tokens = append(tokens, token{kind: tokRBracket, text: "]", pos: i})
+			i++
+		case r == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			tokens = append(tokens, token{kind: tokOp, text: "**", pos: i})
+			i += 2
+		case (r == '<' || r == '>') && i+1 < len(runes) && runes[i+1] == r:
+			tokens = append(tokens, token{kind: tokOp, text: string(runes[i : i+2]), pos: i})
+			i += 2
+		case strings.ContainsRune("+-*/%^&|~", r):
+			tokens = append(tokens, token{kind: tokOp, text: string(r), pos: i})
+			i++
+		default:
+			return nil, &SyntaxError{Offset: i, Token: string(r), Message: "unexpected character"}
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, pos: len(runes)})
+	return tokens, nil
+}
+
+// baseDigits — цифры целых с префиксом основания по букве префикса.
+var baseDigits = map[rune]string{
+	'b': "01",
+	'o': "01234567",
+	'x': "0123456789abcdefABCDEF",
+}
+
+func isAlnum(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// node — узел дерева выражения.
+type node interface {
+	offset() int
+}
+
+type numberNode struct {
+	text string
+	pos  int
+}
+
+type identNode struct {
+	name string
+	pos  int
+}
+
+type unaryNode struct {
+	op  string
+	x   node
+	pos int
+}
+
+type binaryNode struct {
+	op   string
+	x, y node
+	pos  int
+}
+
+type callNode struct {
+	name string
+	args []node
+	pos  int
+}
+
+// convertNode — перевод значения x в единицы unit: "x to unit" (режим units).
+type convertNode struct {
+	x    node
+	unit node
+	pos  int
+}
+
+// arrayNode — литерал вектора или матрицы: "[1, 2]", "[[1, 2], [3, 4]]" (режим matrix).
+type arrayNode struct {
+	elems []node
+	pos   int
+}
+
+func (n *numberNode) offset() int  { return n.pos }
+func (n *identNode) offset() int   { return n.pos }
+func (n *unaryNode) offset() int   { return n.pos }
+func (n *binaryNode) offset() int  { return n.pos }
+func (n *callNode) offset() int    { return n.pos }
+func (n *convertNode) offset() int { return n.pos }
+func (n *arrayNode) offset() int   { return n.pos }
+
+// identifiers — имена переменных в дереве, без повторов, в порядке появления.
+func identifiers(root node) []string {
+	var names []string
+	seen := map[string]bool{}
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case *identNode:
+			if !seen[n.name] {
+				seen[n.name] = true
+				names = append(names, n.name)
+			}
+		case *unaryNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.x)
+			walk(n.y)
+		case *callNode:
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		case *convertNode:
+			walk(n.x)
+		case *arrayNode:
+			for _, elem := range n.elems {
+				walk(elem)
+			}
+		}
+	}
+	walk(root)
+	return names
+}
+
+// calls — вызовы функций в дереве в порядке появления.
+func calls(root node) []*callNode {
+	var found []*callNode
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case *unaryNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.x)
+			walk(n.y)
+		case *callNode:
+			found = append(found, n)
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		case *convertNode:
+			walk(n.x)
+		case *arrayNode:
+			for _, elem := range n.elems {
+				walk(elem)
+			}
+		}
+	}
+	walk(root)
+	return found
+}
+
+// unknownCall — ошибка для первого вызова функции, которой нет в functions.
+// Движки, разбирающие выражения сами (govaluate), используют её, чтобы
+// вместо общей ошибки разбора сообщить, какой функции не хватает.
+func unknownCall(expression string, functions map[string]*Function) error {
+	root, err := parseExpression(expression)
+	if err != nil {
+		return nil
+	}
+	for _, call := range calls(root) {
+		if _, ok := functions[call.name]; !ok {
+			return unsupportedNode(call)
+		}
+	}
+	return nil
+}
+
+// parser — разбор методом рекурсивного спуска. Приоритеты (от слабого к сильному):
+// + -, затем * / %, затем унарные + -, затем ^ или ** (правоассоциативная степень).
+//
+// В режиме units (units = true) число и стоящие за ним имена перемножаются
+// без знака и сильнее деления: "5 km / 20 min" — это (5 km) / (20 min).
+// Выражение может заканчиваться переводом в другие единицы: "... to km/h"
+// или "... in GBP".
+//
+// В режиме programmer (programmer = true) приоритеты как в C: слабее
+// сложения идут сдвиги << >>, затем &, затем ^ (исключающее ИЛИ), затем |;
+// степень — только **, унарный ~ — побитовое НЕ.
+//
+// В режиме matrix (matrix = true) в квадратных скобках через запятую
+// записываются векторы и матрицы по строкам: [[1, 2], [3, 4]] * [5, 6].
+type parser struct {
+	tokens     []token
+	i          int
+	units      bool
+	programmer bool
+	matrix     bool
+}
+
+// Слова перевода в другие единицы в режиме units. "in" — слово перевода,
+// только если за ним идёт имя ("120 USD in GBP"); иначе это дюйм ("3 in + 1 ft").
+const (
+	convertKeyword   = "to"
+	convertKeywordIn = "in"
+)
+
+// parseExpression — разбирает выражение целиком и возвращает корень дерева.
+func parseExpression(expression string) (node, error) {
+	return parse(expression, parser{})
+}
+
+// parseUnitsExpression — разбирает выражение с единицами измерения.
+func parseUnitsExpression(expression string) (node, error) {
+	return parse(expression, parser{units: true})
+}
+
+// parseProgrammerExpression — разбирает целочисленное выражение с побитовыми операциями.
+func parseProgrammerExpression(expression string) (node, error) {
+	return parse(expression, parser{programmer: true})
+}
+
+// parseMatrixExpression — разбирает выражение с литералами векторов и матриц.
+func parseMatrixExpression(expression string) (node, error) {
+	return parse(expression, parser{matrix: true})
+}
+
+func parse(expression string, p parser) (node, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	p.tokens = tokens
+	n, err := p.parseTop()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); p.atConvert() {
+		p.next()
+		unit, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		n = &convertNode{x: n, unit: unit, pos: tok.pos}
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, p.errorAt(tok, "unexpected token")
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token { return p.tokens[p.i] }
+
+// atConvert — стоит ли разбор на слове перевода в другие единицы.
+func (p *parser) atConvert() bool {
+	tok := p.peek()
+	if !p.units || tok.kind != tokIdent {
+		return false
+	}
+	switch tok.text {
+	case convertKeyword:
+		return true
+	case convertKeywordIn:
+		return p.tokens[p.i+1].kind == tokIdent
+	}
+	return false
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokEOF {
+		p.i++
+	}
+	return tok
+}
+
+func (p *parser) errorAt(tok token, msg string) error {
+	if tok.kind == tokEOF {
+		return &SyntaxError{Offset: tok.pos, Message: "unexpected end of expression"}
+	}
+	return &SyntaxError{Offset: tok.pos, Token: tok.text, Message: msg}
+}
+
+func (p *parser) isOp(ops ...string) bool {
+	tok := p.peek()
+	if tok.kind != tokOp {
+		return false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTop — выражение целиком: в скобках, аргументах функций и на верхнем уровне.
+func (p *parser) parseTop() (node, error) {
+	if p.programmer {
+		return p.parseBinary(0)
+	}
+	return p.parseAdditive()
+}
+
+// bitwiseLevels — операторы режима programmer от слабого к сильному;
+// сильнее последнего уровня — сложение.
+var bitwiseLevels = [][]string{{"|"}, {"^"}, {"&"}, {"<<", ">>"}}
+
+// parseBinary — левоассоциативные побитовые операторы уровня level и сильнее.
+func (p *parser) parseBinary(level int) (node, error) {
+	if level == len(bitwiseLevels) {
+		return p.parseAdditive()
+	}
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp(bitwiseLevels[level]...) {
+		op := p.next()
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: op.text, x: x, y: y, pos: op.pos}
+	}
+	return x, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	x, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+", "-") {
+		op := p.next()
+		y, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: op.text, x: x, y: y, pos: op.pos}
+	}
+	return x, nil
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	x, err := p.parseJuxtaposed()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*", "/", "%") {
+		op := p.next()
+		y, err := p.parseJuxtaposed()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: op.text, x: x, y: y, pos: op.pos}
+	}
+	return x, nil
+}
+
+// parseJuxtaposed — в режиме units произведение без знака: "5 km", "2 kg m".
+// Вне режима units — просто унарное выражение.
+func (p *parser) parseJuxtaposed() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.units && p.peek().kind == tokIdent && !p.atConvert() {
+		pos := p.peek().pos
+		y, err := p.parsePower()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: "*", x: x, y: y, pos: pos}
+	}
+	return x, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("+", "-") || (p.programmer && p.isOp("~")) {
+		op := p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: op.text, x: x, pos: op.pos}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *parser) parsePower() (node, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("**") || (!p.programmer && p.isOp("^")) {
+		op := p.next()
+		// правоассоциативно и с унарным минусом в показателе: 2^-1, 2^3^2
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		// Вне режима programmer ** и ^ — одна операция "^"; в нём "^" — это XOR.
+		power := "^"
+		if p.programmer {
+			power = "**"
+		}
+		return &binaryNode{op: power, x: x, y: y, pos: op.pos}, nil
+	}
+	return x, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		return &numberNode{text: tok.text, pos: tok.pos}, nil
+	case tokIdent:
+		if p.peek().kind != tokLParen {
+			return &identNode{name: tok.text, pos: tok.pos}, nil
+		}
+		p.next()
+		call := &callNode{name: tok.text, pos: tok.pos}
+		if p.peek().kind == tokRParen {
+			p.next()
+			return call, nil
+		}
+		for {
+			arg, err := p.parseTop()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			sep := p.next()
+			if sep.kind == tokRParen {
+				return call, nil
+			}
+			if sep.kind != tokComma {
+				return nil, p.errorAt(sep, "expected ',' or ')'")
+			}
+		}
+	case tokLParen:
+		x, err := p.parseTop()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, p.errorAt(closing, "expected ')'")
+		}
+		return x, nil
+	case tokLBracket:
+		if !p.matrix {
+			return nil, p.errorAt(tok, "unexpected token")
+		}
+		array := &arrayNode{pos: tok.pos}
+		if p.peek().kind == tokRBracket {
+			return nil, p.errorAt(p.peek(), "empty vector")
+		}
+		for {
+			elem, err := p.parseTop()
+			if err != nil {
+				return nil, err
+			}
+			array.elems = append(array.elems, elem)
+			sep := p.next()
+			if sep.kind == tokRBracket {
+				return array, nil
+			}
+			if sep.kind != tokComma {
+				return nil, p.errorAt(sep, "expected ',' or ']'")
+			}
+		}
+	default:
+		return nil, p.errorAt(tok, "unexpected token")
+	}
+}
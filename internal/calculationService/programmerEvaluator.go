@@ -0,0 +1,262 @@
+package calculationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// ProgrammerEngine — имя движка целочисленной арифметики фиксированной ширины.
+const ProgrammerEngine = "programmer"
+
+// programmerEvaluator — движок «калькулятора программиста»: целые типа
+// Env.WordType (int8..uint64) с переполнением как в машине — по модулю
+// 2^bits, литералы 0x1F, 0b1010, 0o17, побитовые & | ^ ~, сдвиги << >>
+// (у знаковых типов >> арифметический), степень ** и функции rol, ror,
+// popcount. Результат записывается в десятичном виде; в остальных
+// системах счисления его даёт FormatBases.
+type programmerEvaluator struct{}
+
+// NewProgrammerEvaluator — создаёт движок целочисленной арифметики.
+func NewProgrammerEvaluator() Evaluator {
+	return programmerEvaluator{}
+}
+
+func (programmerEvaluator) Name() string { return ProgrammerEngine }
+
+func (programmerEvaluator) Capabilities() Capabilities {
+	return Capabilities{
+		Description: "Programmer integers: signed and unsigned 8/16/32/64-bit words with wrap-around overflow, binary/octal/hex literals, bitwise & | ^ ~, shifts << >>, rol/ror/popcount",
+		Modes:       []string{ModeProgrammer},
+		Variables:   true,
+		Functions:   false,
+	}
+}
+
+func (programmerEvaluator) Parse(expression string, env Env) (Program, error) {
+	root, err := parseProgrammerExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if err := env.Limits.checkTree(expression, root); err != nil {
+		return nil, err
+	}
+	return &astProgram{source: expression, root: root}, nil
+}
+
+func (programmerEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	p, ok := program.(*astProgram)
+	if !ok {
+		return Evaluation{}, fmt.Errorf("programmer: foreign program %T", program)
+	}
+	env.ctx = ctx
+
+	w, ok := wordTypes[env.WordType]
+	if !ok {
+		return Evaluation{}, fmt.Errorf("%w: %q", ErrInvalidWordType, env.WordType)
+	}
+	x, err := evalWord(p.root, w, env)
+	if err != nil {
+		return Evaluation{}, err
+	}
+	return Evaluation{Result: w.format(x)}, nil
+}
+
+// parseWord — целое из литерала или значения переменной. Число, не
+// помещающееся в 64 бита, — ErrOverflow; в более узкий тип оно
+// укладывается по модулю 2^bits, как при приведении типов в C.
+func parseWord(text string, w wordType) (uint64, error) {
+	digits, base := text, 10
+	if len(text) > 2 && text[0] == '0' {
+		switch strings.ToLower(text[1:2]) {
+		case "x":
+			digits, base = text[2:], 16
+		case "b":
+			digits, base = text[2:], 2
+		case "o":
+			digits, base = text[2:], 8
+		}
+	}
+	negative := base == 10 && strings.HasPrefix(digits, "-")
+	x, err := strconv.ParseUint(strings.TrimPrefix(digits, "-"), base, 64)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return 0, fmt.Errorf("%w: %s does not fit in 64 bits", ErrOverflow, text)
+		}
+		return 0, err
+	}
+	if negative {
+		x = -x
+	}
+	return w.norm(x), nil
+}
+
+// evalWord — вычисляет дерево в словах типа w.
+func evalWord(n node, w wordType, env Env) (uint64, error) {
+	if err := env.interrupted(); err != nil {
+		return 0, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		x, err := parseWord(n.text, w)
+		if err != nil && !errors.Is(err, ErrOverflow) {
+			return 0, &SyntaxError{Offset: n.pos, Token: n.text, Message: "programmer mode supports only integers"}
+		}
+		return x, err
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			return 0, unsupportedNode(n)
+		}
+		x, err := parseWord(value, w)
+		if err != nil {
+			return 0, fmt.Errorf("%w: variable %q = %s is not an integer", ErrDomain, n.name, value)
+		}
+		return x, nil
+	case *callNode:
+		f, ok := wordFunctions[n.name]
+		if !ok {
+			return 0, unsupportedNode(n)
+		}
+		if len(n.args) != f.args {
+			return 0, fmt.Errorf("%w: %s takes %d argument(s), got %d", ErrArity, n.name, f.args, len(n.args))
+		}
+		args := make([]uint64, len(n.args))
+		for i, arg := range n.args {
+			x, err := evalWord(arg, w, env)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = x
+		}
+		return f.fn(w, args)
+	case *unaryNode:
+		x, err := evalWord(n.x, w, env)
+		if err != nil {
+			return 0, err
+		}
+		switch n.op {
+		case "-":
+			x = -x
+		case "~":
+			x = ^x
+		}
+		return w.norm(x), nil
+	case *binaryNode:
+		x, err := evalWord(n.x, w, env)
+		if err != nil {
+			return 0, err
+		}
+		y, err := evalWord(n.y, w, env)
+		if err != nil {
+			return 0, err
+		}
+		z, err := wordOp(n.op, x, y, w)
+		if err != nil {
+			return 0, err
+		}
+		return w.norm(z), nil
+	}
+	return 0, unsupportedNode(n)
+}
+
+// wordOp — бинарная операция над словами типа w (результат ещё не обрезан).
+func wordOp(op string, x, y uint64, w wordType) (uint64, error) {
+	switch op {
+	case "+":
+		return x + y, nil
+	case "-":
+		return x - y, nil
+	case "*":
+		return x * y, nil
+	case "/", "%":
+		if y == 0 {
+			return 0, ErrDivisionByZero
+		}
+		if !w.signed {
+			if op == "/" {
+				return x / y, nil
+			}
+			return x % y, nil
+		}
+		a, b := int64(x), int64(y)
+		if b == -1 {
+			// MinInt64 / -1 переполняется так же, как остальные типы: по модулю.
+			if op == "/" {
+				return -x, nil
+			}
+			return 0, nil
+		}
+		if op == "/" {
+			return uint64(a / b), nil
+		}
+		return uint64(a % b), nil
+	case "&":
+		return x & y, nil
+	case "|":
+		return x | y, nil
+	case "^":
+		return x ^ y, nil
+	case "<<", ">>":
+		if w.negative(y) {
+			return 0, fmt.Errorf("%w: negative shift count %d", ErrDomain, int64(y))
+		}
+		if y >= uint64(w.bits) {
+			if op == ">>" && w.negative(x) {
+				return ^uint64(0), nil
+			}
+			return 0, nil
+		}
+		if op == "<<" {
+			return x << y, nil
+		}
+		if w.signed {
+			return uint64(int64(x) >> y), nil
+		}
+		return x >> y, nil
+	case "**":
+		if w.negative(y) {
+			return 0, fmt.Errorf("%w: negative exponent %d in integer power", ErrDomain, int64(y))
+		}
+		// возведение в квадрат с переполнением по модулю 2^64
+		z := uint64(1)
+		for ; y > 0; y >>= 1 {
+			if y&1 == 1 {
+				z *= x
+			}
+			x *= x
+		}
+		return z, nil
+	}
+	return 0, fmt.Errorf("unsupported operator %q in programmer mode", op)
+}
+
+// wordFunction — встроенная функция режима programmer.
+type wordFunction struct {
+	args int
+	fn   func(w wordType, args []uint64) (uint64, error)
+}
+
+// wordFunctions — функции режима programmer: циклические сдвиги на n бит
+// в пределах слова и число единичных бит.
+var wordFunctions = map[string]wordFunction{
+	"rol":      {2, func(w wordType, a []uint64) (uint64, error) { return rotate(w, a[0], a[1], true) }},
+	"ror":      {2, func(w wordType, a []uint64) (uint64, error) { return rotate(w, a[0], a[1], false) }},
+	"popcount": {1, func(w wordType, a []uint64) (uint64, error) { return uint64(bits.OnesCount64(a[0] & w.mask())), nil }},
+}
+
+// rotate — циклический сдвиг x на n бит влево (left) или вправо.
+func rotate(w wordType, x, n uint64, left bool) (uint64, error) {
+	if w.negative(n) {
+		return 0, fmt.Errorf("%w: negative rotation count %d", ErrDomain, int64(n))
+	}
+	k := uint(n % uint64(w.bits))
+	if !left {
+		k = (w.bits - k) % w.bits
+	}
+	x &= w.mask()
+	return w.norm(x<<k | x>>(w.bits-k)), nil
+}
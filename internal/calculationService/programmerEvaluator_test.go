@@ -0,0 +1,106 @@
+package calculationService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProgrammer(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wordType   string
+		want       string
+		wantErr    error
+	}{
+		{name: "шестнадцатеричный литерал", expression: "0xFF + 1", want: "256"},
+		{name: "двоичный и восьмеричный", expression: "0b1010 + 0o17", want: "25"},
+		{name: "приоритеты как в C", expression: "1 | 2 ^ 3 & 4 << 1", want: "3"},
+		{name: "сдвиг слабее сложения", expression: "1 << 2 + 1", want: "8"},
+		{name: "побитовое НЕ", expression: "~0", want: "-1"},
+		{name: "степень", expression: "2 ** 10", want: "1024"},
+		{name: "целочисленное деление", expression: "-7 / 2", want: "-3"},
+		{name: "переполнение uint8", expression: "255 + 1", wordType: WordUint8, want: "0"},
+		{name: "переполнение int8", expression: "127 + 1", wordType: WordInt8, want: "-128"},
+		{name: "литерал обрезается до слова", expression: "0x1FF", wordType: WordUint8, want: "255"},
+		{name: "отрицательное в беззнаковом", expression: "-1", wordType: WordUint16, want: "65535"},
+		{name: "арифметический сдвиг", expression: "-16 >> 2", wordType: WordInt8, want: "-4"},
+		{name: "логический сдвиг", expression: "0xF0 >> 4", wordType: WordUint8, want: "15"},
+		{name: "сдвиг на ширину слова", expression: "1 << 32", wordType: WordUint32, want: "0"},
+		{name: "циклический сдвиг влево", expression: "rol(0x81, 1)", wordType: WordUint8, want: "3"},
+		{name: "циклический сдвиг вправо", expression: "ror(1, 1)", wordType: WordUint32, want: "2147483648"},
+		{name: "число единиц", expression: "popcount(-1)", wordType: WordInt16, want: "16"},
+		{name: "максимум uint64", expression: "0xFFFFFFFFFFFFFFFF", wordType: WordUint64, want: "18446744073709551615"},
+		{name: "минимум int64 на минус один", expression: "(1 << 63) / -1", want: "-9223372036854775808"},
+		{name: "дробное число", expression: "1.5 + 1", wantErr: &SyntaxError{}},
+		{name: "больше 64 бит", expression: "0x1FFFFFFFFFFFFFFFF", wantErr: ErrOverflow},
+		{name: "деление на ноль", expression: "1 % 0", wantErr: ErrDivisionByZero},
+		{name: "отрицательный сдвиг", expression: "1 << -1", wantErr: ErrDomain},
+		{name: "число аргументов", expression: "rol(1)", wantErr: ErrArity},
+		{name: "функции float нет", expression: "sqrt(4)", wantErr: ErrUnknownFunction},
+		{name: "неизвестный тип слова", expression: "1", wordType: "int128", wantErr: ErrInvalidWordType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service := NewCalculationService(mockRepo)
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", EvalOptions{Mode: ModeProgrammer, WordType: tt.wordType})
+
+			if syntaxErr, ok := tt.wantErr.(*SyntaxError); ok {
+				assert.ErrorAs(t, err, &syntaxErr)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, ProgrammerEngine, result.Engine)
+				assert.Equal(t, tt.want, result.Result)
+				wantType := tt.wordType
+				if wantType == "" {
+					wantType = DefaultWordType
+				}
+				assert.Equal(t, wantType, result.WordType)
+			}
+		})
+	}
+}
+
+func TestProgrammerWordTypeOnlyInProgrammerMode(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil)
+
+	service := NewCalculationService(mockRepo)
+	result, err := service.CreateCalculation(t.Context(), "200 + 100", "", EvalOptions{WordType: WordUint8})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "300", result.Result, "тип слова не влияет на режим float")
+	assert.Empty(t, result.WordType)
+}
+
+func TestFormatBases(t *testing.T) {
+	tests := []struct {
+		result   string
+		wordType string
+		want     Bases
+		ok       bool
+	}{
+		{"10", WordInt64, Bases{Bin: "0b1010", Oct: "0o12", Dec: "10", Hex: "0xa"}, true},
+		{"-11", WordInt8, Bases{Bin: "0b11110101", Oct: "0o365", Dec: "-11", Hex: "0xf5"}, true},
+		{"-1", WordInt16, Bases{Bin: "0b1111111111111111", Oct: "0o177777", Dec: "-1", Hex: "0xffff"}, true},
+		{"0", WordUint32, Bases{Bin: "0b0", Oct: "0o0", Dec: "0", Hex: "0x0"}, true},
+		{"4", "", Bases{}, false},
+		{"1.5", WordInt64, Bases{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := FormatBases(tt.result, tt.wordType)
+		assert.Equal(t, tt.ok, ok, tt.result)
+		assert.Equal(t, tt.want, got, tt.result)
+	}
+}
@@ -0,0 +1,30 @@
+package calculationService
+
+import (
+	"context"
+	"time"
+)
+
+// RateSource — откуда сервис берёт курсы валют для режима units.
+// RatesAt возвращает курсы, действовавшие в момент at: сколько единиц
+// валюты дают за одну базовую, по коду валюты (у базовой валюты "1").
+type RateSource interface {
+	RatesAt(ctx context.Context, at time.Time) (map[string]string, error)
+}
+
+// WithRateSource — подключает таблицу курсов валют.
+// Без неё в режиме units валюты недоступны.
+func WithRateSource(src RateSource) Option {
+	return func(s *calcService) {
+		s.rates = src
+	}
+}
+
+// ratesAt — курсы на момент at для окружения env; nil — валюты
+// в этом режиме не нужны или таблица курсов не подключена.
+func (s *calcService) ratesAt(ctx context.Context, env Env, at time.Time) (map[string]string, error) {
+	if s.rates == nil || env.Mode != ModeUnits {
+		return nil, nil
+	}
+	return s.rates.RatesAt(ctx, at)
+}
@@ -1,16 +1,52 @@
 package calculationService
 
 import (
+	"context"
+	"strings"
+	"time"
+
 	"gorm.io/gorm"
 )
 
 // Основные методы CRUD — Create, Read, Update, Delete.
 type CalculationRepository interface {
-	CreateCalculation(calc Calculation) error
-	GetAllCalculations() ([]Calculation, error)
-	GetCalculationByID(id string) (Calculation, error)
-	UpdateCalculation(calc Calculation) error
-	DeleteCalculation(id string) error
+	CreateCalculation(ctx context.Context, calc Calculation) error
+	GetAllCalculations(ctx context.Context) ([]Calculation, error)
+	GetCalculationByID(ctx context.Context, id string) (Calculation, error)
+	UpdateCalculation(ctx context.Context, calc Calculation) error
+	DeleteCalculation(ctx context.Context, id string) error
+
+	// Варианты, ограниченные записями одного владельца (user_id).
+	// Чужая запись для них неотличима от несуществующей.
+	GetAllCalculationsForUser(ctx context.Context, userID string) ([]Calculation, error)
+	GetCalculationByIDForUser(ctx context.Context, id, userID string) (Calculation, error)
+	UpdateCalculationForUser(ctx context.Context, calc Calculation, userID string) error
+	DeleteCalculationForUser(ctx context.Context, id, userID string) error
+
+	// Обновление записи применяется, только если её версия в БД равна
+	// calc.Version, и увеличивает версию на 1; иначе — ErrVersionMismatch.
+	// Удаление с той же проверкой — варианты IfVersion.
+	DeleteCalculationIfVersion(ctx context.Context, id string, version int) error
+	DeleteCalculationForUserIfVersion(ctx context.Context, id, userID string, version int) error
+
+	// Страница истории (или корзины, q.Trash) по запросу q: всех
+	// пользователей или одного.
+	ListCalculations(ctx context.Context, q ListQuery) ([]Calculation, error)
+	ListCalculationsForUser(ctx context.Context, q ListQuery, userID string) ([]Calculation, error)
+
+	// Восстановление записи из корзины; для записи не из корзины ничего не
+	// меняет. Несуществующая запись — gorm.ErrRecordNotFound.
+	RestoreCalculation(ctx context.Context, id string) error
+	RestoreCalculationForUser(ctx context.Context, id, userID string) error
+
+	// PurgeDeleted — окончательно удаляет записи, попавшие в корзину
+	// раньше before, вместе с их ревизиями.
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+
+	// Ревизии записи: создание и изменения записи сохраняют их сами, в той
+	// же транзакции. Номера идут по возрастанию.
+	GetRevisions(ctx context.Context, calculationID string) ([]Revision, error)
+	GetRevision(ctx context.Context, calculationID string, number int) (Revision, error)
 }
 
 // calcRepository — структура, которая реализует интерфейс CalculationRepository.
@@ -24,31 +60,294 @@ func NewCalculationRepository(db *gorm.DB) CalculationRepository {
 	return &calcRepository{db: db}
 }
 
-// CreateCalculation — создаёт (добавляет) новую запись в БД.
-func (r *calcRepository) CreateCalculation(calc Calculation) error {
-	return r.db.Create(&calc).Error
+// CreateCalculation — создаёт (добавляет) новую запись в БД и её первую ревизию.
+func (r *calcRepository) CreateCalculation(ctx context.Context, calc Calculation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&calc).Error; err != nil {
+			return err
+		}
+		return addRevision(tx, calc)
+	})
 }
 
-// GetAllCalculations — возвращает все записи из таблицы calculations.
-func (r *calcRepository) GetAllCalculations() ([]Calculation, error) {
+// GetAllCalculations — возвращает все записи из таблицы calculations по
+// времени создания (как memoryRepository).
+func (r *calcRepository) GetAllCalculations(ctx context.Context) ([]Calculation, error) {
 	var calculations []Calculation
-	err := r.db.Find(&calculations).Error
+	err := r.db.WithContext(ctx).Order("created_at, id").Find(&calculations).Error
 	return calculations, err
 }
 
 // GetCalculationByID — ищет конкретную запись по ID.
-func (r *calcRepository) GetCalculationByID(id string) (Calculation, error) {
+func (r *calcRepository) GetCalculationByID(ctx context.Context, id string) (Calculation, error) {
+	var calc Calculation
+	err := r.db.WithContext(ctx).First(&calc, "id = ?", id).Error
+	return calc, err
+}
+
+// UpdateCalculation — обновляет выражение и результат существующей записи (по ID из calc).
+// Если записи нет, возвращает gorm.ErrRecordNotFound, а не создаёт новую.
+func (r *calcRepository) UpdateCalculation(ctx context.Context, calc Calculation) error {
+	return r.update(ctx, calc, "id = ?", calc.ID)
+}
+
+// DeleteCalculation — переносит запись в корзину по ID.
+func (r *calcRepository) DeleteCalculation(ctx context.Context, id string) error {
+	return r.delete(ctx, 0, "id = ?", id)
+}
+
+// DeleteCalculationIfVersion — как DeleteCalculation, но только для записи версии version.
+func (r *calcRepository) DeleteCalculationIfVersion(ctx context.Context, id string, version int) error {
+	return r.delete(ctx, version, "id = ?", id)
+}
+
+// GetAllCalculationsForUser — возвращает записи одного пользователя по времени создания.
+func (r *calcRepository) GetAllCalculationsForUser(ctx context.Context, userID string) ([]Calculation, error) {
+	var calculations []Calculation
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at, id").Find(&calculations).Error
+	return calculations, err
+}
+
+// GetCalculationByIDForUser — ищет запись по ID среди записей пользователя.
+func (r *calcRepository) GetCalculationByIDForUser(ctx context.Context, id, userID string) (Calculation, error) {
 	var calc Calculation
-	err := r.db.First(&calc, "id = ?", id).Error
+	err := r.db.WithContext(ctx).First(&calc, "id = ? AND user_id = ?", id, userID).Error
 	return calc, err
 }
 
-// UpdateCalculation — обновляет существующую запись (по ID из calc).
-func (r *calcRepository) UpdateCalculation(calc Calculation) error {
-	return r.db.Save(&calc).Error
+// UpdateCalculationForUser — как UpdateCalculation, но только для записи пользователя.
+func (r *calcRepository) UpdateCalculationForUser(ctx context.Context, calc Calculation, userID string) error {
+	return r.update(ctx, calc, "id = ? AND user_id = ?", calc.ID, userID)
+}
+
+// DeleteCalculationForUser — как DeleteCalculation, но только для записи пользователя.
+func (r *calcRepository) DeleteCalculationForUser(ctx context.Context, id, userID string) error {
+	return r.delete(ctx, 0, "id = ? AND user_id = ?", id, userID)
+}
+
+// DeleteCalculationForUserIfVersion — как DeleteCalculationForUser, но только для записи версии version.
+func (r *calcRepository) DeleteCalculationForUserIfVersion(ctx context.Context, id, userID string, version int) error {
+	return r.delete(ctx, version, "id = ? AND user_id = ?", id, userID)
+}
+
+// ListCalculations — страница записей всех пользователей.
+func (r *calcRepository) ListCalculations(ctx context.Context, q ListQuery) ([]Calculation, error) {
+	return r.list(r.db.WithContext(ctx), q)
+}
+
+// ListCalculationsForUser — страница записей одного пользователя.
+func (r *calcRepository) ListCalculationsForUser(ctx context.Context, q ListQuery, userID string) ([]Calculation, error) {
+	return r.list(r.db.WithContext(ctx).Where("user_id = ?", userID), q)
+}
+
+// list — записи из scope, отобранные фильтром q.Filter, после курсора
+// q.After, в порядке q.Sort и q.Order; не больше q.Limit. ID — второй
+// ключ сортировки, чтобы порядок был полным и курсор однозначным.
+// Записи без числового результата при сортировке по результату идут
+// последними в обоих направлениях.
+func (r *calcRepository) list(scope *gorm.DB, q ListQuery) ([]Calculation, error) {
+	if q.Trash {
+		scope = scope.Unscoped().Where("deleted_at IS NOT NULL")
+	}
+	scope = filter(scope, q.Filter)
+
+	cmp, dir := ">", "ASC"
+	if q.Order == OrderDesc {
+		cmp, dir = "<", "DESC"
+	}
+	switch q.Sort {
+	case SortResult:
+		if after := q.After; after != nil && after.Value != nil {
+			scope = scope.Where("result_value IS NULL OR result_value "+cmp+" ? OR (result_value = ? AND id "+cmp+" ?)", *after.Value, *after.Value, after.ID)
+		} else if after != nil {
+			scope = scope.Where("result_value IS NULL AND id "+cmp+" ?", after.ID)
+		}
+		scope = scope.Order("result_value IS NULL").Order("result_value " + dir)
+	default:
+		column := "created_at"
+		if q.Sort == SortDeletedAt {
+			column = "deleted_at"
+		}
+		if after := q.After; after != nil {
+			scope = scope.Where(column+" "+cmp+" ? OR ("+column+" = ? AND id "+cmp+" ?)", after.Time, after.Time, after.ID)
+		}
+		scope = scope.Order(column + " " + dir)
+	}
+
+	var calculations []Calculation
+	err := scope.Order("id " + dir).Limit(q.Limit).Find(&calculations).Error
+	return calculations, err
+}
+
+// RestoreCalculation — возвращает запись из корзины.
+func (r *calcRepository) RestoreCalculation(ctx context.Context, id string) error {
+	return r.restore(r.db.WithContext(ctx).Where("id = ?", id))
+}
+
+// RestoreCalculationForUser — как RestoreCalculation, но только для записи пользователя.
+func (r *calcRepository) RestoreCalculationForUser(ctx context.Context, id, userID string) error {
+	return r.restore(r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID))
+}
+
+// restore — снимает отметку об удалении с записей, отобранных scope.
+// UpdateColumn не трогает updated_at: восстановление — не пересчёт.
+func (r *calcRepository) restore(scope *gorm.DB) error {
+	res := scope.Unscoped().Model(&Calculation{}).UpdateColumn("deleted_at", nil)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PurgeDeleted — окончательно удаляет записи, попавшие в корзину раньше
+// before, и их ревизии.
+func (r *calcRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := tx.Unscoped().Model(&Calculation{}).Select("id").Where("deleted_at IS NOT NULL AND deleted_at < ?", before)
+		if err := tx.Where("calculation_id IN (?)", expired).Delete(&Revision{}).Error; err != nil {
+			return err
+		}
+		res := tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Delete(&Calculation{})
+		purged = res.RowsAffected
+		return res.Error
+	})
+	return purged, err
+}
+
+// filter — условия Filter в виде WHERE.
+func filter(scope *gorm.DB, f Filter) *gorm.DB {
+	if f.Expression != "" {
+		scope = scope.Where(`LOWER(expression) LIKE ? ESCAPE '\'`, "%"+likeEscaper.Replace(strings.ToLower(f.Expression))+"%")
+	}
+	if f.ResultMin != nil {
+		scope = scope.Where("result_value >= ?", *f.ResultMin)
+	}
+	if f.ResultMax != nil {
+		scope = scope.Where("result_value <= ?", *f.ResultMax)
+	}
+	switch f.Status {
+	case StatusSuccess:
+		scope = scope.Where("result <> ''")
+	case StatusError:
+		scope = scope.Where("result = '' OR result IS NULL")
+	}
+	if f.From != nil {
+		scope = scope.Where("created_at >= ?", *f.From)
+	}
+	if f.To != nil {
+		scope = scope.Where("created_at < ?", *f.To)
+	}
+	return scope
+}
+
+// likeEscaper — экранирует спецсимволы LIKE в подстроке поиска.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// update — обновляет выражение, результат и параметры вычисления записи,
+// отобранной условием query, и сохраняет новую ревизию. У записей, созданных
+// до появления ревизий, сначала сохраняется ревизия с прежним состоянием.
+// Запись другой версии, чем calc.Version, не меняется: ErrVersionMismatch.
+func (r *calcRepository) update(ctx context.Context, calc Calculation, query string, args ...interface{}) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current Calculation
+		if err := tx.Where(query, args...).First(&current).Error; err != nil {
+			return err
+		}
+		if current.Version != calc.Version {
+			return ErrVersionMismatch
+		}
+		var revisions int64
+		if err := tx.Model(&Revision{}).Where("calculation_id = ?", current.ID).Count(&revisions).Error; err != nil {
+			return err
+		}
+		if revisions == 0 {
+			if current.UpdatedBy == "" {
+				current.UpdatedBy = current.UserID
+			}
+			if err := addRevision(tx, current); err != nil {
+				return err
+			}
+		}
+
+		res := tx.Model(&Calculation{}).Where(query, args...).Where("version = ?", calc.Version).Updates(map[string]interface{}{
+			"expression":   calc.Expression,
+			"result":       calc.Result,
+			"unit":         calc.Unit,
+			"result_type":  calc.ResultType,
+			"result_value": calc.ResultValue,
+			"engine":       calc.Engine,
+			"mode":         calc.Mode,
+			"precision":    calc.Precision,
+			"word_type":    calc.WordType,
+			"complex_form": calc.ComplexForm,
+			"angle_unit":   calc.AngleUnit,
+			"variables":    calc.Variables,
+			"functions":    calc.Functions,
+			"rates":        calc.Rates,
+			"updated_at":   calc.UpdatedAt,
+			"updated_by":   calc.UpdatedBy,
+			"version":      gorm.Expr("version + 1"),
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// Запись изменили после чтения выше.
+			return ErrVersionMismatch
+		}
+		return addRevision(tx, calc)
+	})
+}
+
+// addRevision — сохраняет снимок calc под следующим номером.
+func addRevision(tx *gorm.DB, calc Calculation) error {
+	var last int
+	if err := tx.Model(&Revision{}).Where("calculation_id = ?", calc.ID).Select("COALESCE(MAX(number), 0)").Scan(&last).Error; err != nil {
+		return err
+	}
+	rev := newRevision(calc, last+1)
+	return tx.Create(&rev).Error
+}
+
+// GetRevisions — ревизии записи по возрастанию номера.
+func (r *calcRepository) GetRevisions(ctx context.Context, calculationID string) ([]Revision, error) {
+	var revisions []Revision
+	err := r.db.WithContext(ctx).Where("calculation_id = ?", calculationID).Order("number").Find(&revisions).Error
+	return revisions, err
+}
+
+// GetRevision — ревизия записи с номером number.
+func (r *calcRepository) GetRevision(ctx context.Context, calculationID string, number int) (Revision, error) {
+	var rev Revision
+	err := r.db.WithContext(ctx).First(&rev, "calculation_id = ? AND number = ?", calculationID, number).Error
+	return rev, err
 }
 
-// DeleteCalculation — удаляет запись по ID.
-func (r *calcRepository) DeleteCalculation(id string) error {
-	return r.db.Delete(&Calculation{}, "id = ?", id).Error
+// delete — переносит в корзину запись, отобранную условием query, если её
+// версия равна version; version 0 — любой версии.
+func (r *calcRepository) delete(ctx context.Context, version int, query string, args ...interface{}) error {
+	scope := r.db.WithContext(ctx).Where(query, args...)
+	if version != 0 {
+		scope = scope.Where("version = ?", version)
+	}
+	res := scope.Delete(&Calculation{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		if version == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		// Запись есть, но другой версии, или её нет вовсе.
+		var current Calculation
+		if err := r.db.WithContext(ctx).Where(query, args...).First(&current).Error; err != nil {
+			return err
+		}
+		return ErrVersionMismatch
+	}
+	return nil
 }
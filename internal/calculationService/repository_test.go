@@ -0,0 +1,305 @@
+package calculationService
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"CalculatorAppFrontendPantela-main/internal/db/dbtest"
+)
+
+// repositories — реализации CalculationRepository, которые должны пройти
+// общие тесты ниже; new возвращает пустой репозиторий.
+var repositories = []struct {
+	name string
+	new  func(t *testing.T) CalculationRepository
+}{
+	{"sqlite", func(t *testing.T) CalculationRepository { return NewCalculationRepository(dbtest.New(t)) }},
+	{"memory", func(*testing.T) CalculationRepository { return NewMemoryRepository() }},
+}
+
+// forEachRepository — запускает test для каждой реализации с записями calcs.
+func forEachRepository(t *testing.T, calcs []Calculation, test func(t *testing.T, repo CalculationRepository)) {
+	for _, impl := range repositories {
+		t.Run(impl.name, func(t *testing.T) {
+			repo := impl.new(t)
+			for _, calc := range calcs {
+				require.NoError(t, repo.CreateCalculation(t.Context(), calc))
+			}
+			test(t, repo)
+		})
+	}
+}
+
+// listIDs — ID записей пользователя userID со всех страниц запроса opts.
+func listIDs(t *testing.T, repo CalculationRepository, userID string, trash bool, opts ListOptions) []string {
+	var ids []string
+	for {
+		q, err := opts.query(trash)
+		require.NoError(t, err)
+		calcs, err := repo.ListCalculationsForUser(t.Context(), q, userID)
+		require.NoError(t, err)
+		page := q.page(calcs)
+		for _, calc := range page.Calculations {
+			ids = append(ids, calc.ID)
+		}
+		if page.NextCursor == "" {
+			return ids
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+func TestRepositoryCreateGet(t *testing.T) {
+	calc := Calculation{
+		ID:          "1",
+		Expression:  "x/3",
+		Result:      "2/3",
+		ResultValue: floatPtr(2.0 / 3),
+		Engine:      DefaultEngine,
+		Mode:        ModeRational,
+		UserID:      "alice",
+		UpdatedBy:   "alice",
+		Variables:   Bindings{"x": "2"},
+		Version:     1,
+		CreatedAt:   testNow,
+		UpdatedAt:   testNow,
+	}
+
+	forEachRepository(t, []Calculation{calc}, func(t *testing.T, repo CalculationRepository) {
+		got, err := repo.GetCalculationByIDForUser(t.Context(), "1", "alice")
+		require.NoError(t, err)
+		assert.True(t, testNow.Equal(got.CreatedAt))
+		got.CreatedAt, got.UpdatedAt = calc.CreatedAt, calc.UpdatedAt
+		assert.Equal(t, calc, got)
+
+		_, err = repo.GetCalculationByIDForUser(t.Context(), "1", "bob")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.ErrorIs(t, repo.CreateCalculation(t.Context(), Calculation{ID: "1", Expression: "1"}), gorm.ErrDuplicatedKey)
+
+		revisions, err := repo.GetRevisions(t.Context(), "1")
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		assert.Equal(t, 1, revisions[0].Number)
+		assert.Equal(t, "x/3", revisions[0].Expression)
+		assert.Equal(t, Bindings{"x": "2"}, revisions[0].Variables)
+
+		// Без версии и времени — значения по умолчанию.
+		require.NoError(t, repo.CreateCalculation(t.Context(), Calculation{ID: "2", Expression: "1", UserID: "bob"}))
+		got, err = repo.GetCalculationByID(t.Context(), "2")
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Version)
+		assert.WithinDuration(t, time.Now(), got.CreatedAt, time.Minute)
+
+		all, err := repo.GetAllCalculations(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+}
+
+func TestRepositoryGetAllOrder(t *testing.T) {
+	later := testNow.Add(time.Minute)
+	calcs := []Calculation{
+		{ID: "c", Expression: "1", UserID: "alice", CreatedAt: later},
+		{ID: "b", Expression: "1", UserID: "alice", CreatedAt: testNow},
+		{ID: "a", Expression: "1", UserID: "alice", CreatedAt: later},
+		{ID: "d", Expression: "1", UserID: "bob", CreatedAt: testNow},
+	}
+	ids := func(calcs []Calculation) []string {
+		var ids []string
+		for _, calc := range calcs {
+			ids = append(ids, calc.ID)
+		}
+		return ids
+	}
+
+	forEachRepository(t, calcs, func(t *testing.T, repo CalculationRepository) {
+		all, err := repo.GetAllCalculations(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "d", "a", "c"}, ids(all), "по created_at, затем по id")
+
+		all, err = repo.GetAllCalculationsForUser(t.Context(), "alice")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "a", "c"}, ids(all))
+	})
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	calcs := []Calculation{{ID: "1", Expression: "2+2", Result: "4", UserID: "alice", UpdatedBy: "alice", Version: 1, CreatedAt: testNow}}
+
+	forEachRepository(t, calcs, func(t *testing.T, repo CalculationRepository) {
+		update := Calculation{ID: "1", Expression: "3*3", Result: "9", UserID: "alice", UpdatedBy: "bob", Version: 1, UpdatedAt: testNow.Add(time.Minute)}
+		require.NoError(t, repo.UpdateCalculation(t.Context(), update))
+		assert.ErrorIs(t, repo.UpdateCalculation(t.Context(), update), ErrVersionMismatch, "stale version")
+		assert.ErrorIs(t, repo.UpdateCalculationForUser(t.Context(), Calculation{ID: "1", Version: 2}, "bob"), gorm.ErrRecordNotFound)
+		assert.ErrorIs(t, repo.UpdateCalculation(t.Context(), Calculation{ID: "2", Version: 1}), gorm.ErrRecordNotFound)
+
+		got, err := repo.GetCalculationByID(t.Context(), "1")
+		require.NoError(t, err)
+		assert.Equal(t, "3*3", got.Expression)
+		assert.Equal(t, 2, got.Version)
+		assert.Equal(t, "bob", got.UpdatedBy)
+		assert.True(t, testNow.Equal(got.CreatedAt), "created_at is kept")
+
+		rev, err := repo.GetRevision(t.Context(), "1", 2)
+		require.NoError(t, err)
+		assert.Equal(t, "3*3", rev.Expression)
+		assert.Equal(t, "bob", rev.AuthorID)
+		_, err = repo.GetRevision(t.Context(), "1", 3)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+func TestRepositoryConcurrentUpdates(t *testing.T) {
+	calcs := []Calculation{{ID: "1", Expression: "0", Result: "0", UserID: "alice", Version: 1}}
+
+	forEachRepository(t, calcs, func(t *testing.T, repo CalculationRepository) {
+		const writers = 8
+		errs := make([]error, writers)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = repo.UpdateCalculationForUser(t.Context(), Calculation{ID: "1", Expression: "1", Result: "1", Version: 1}, "alice")
+			}()
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			} else {
+				assert.ErrorIs(t, err, ErrVersionMismatch)
+			}
+		}
+		assert.Equal(t, 1, succeeded, "only one writer wins version 1")
+		revisions, err := repo.GetRevisions(t.Context(), "1")
+		require.NoError(t, err)
+		assert.Len(t, revisions, 2)
+	})
+}
+
+// TestRepositoryUpdateWithoutRevisions — записи, созданные до появления
+// ревизий, бывают только в БД.
+func TestRepositoryUpdateWithoutRevisions(t *testing.T) {
+	conn := dbtest.New(t)
+	require.NoError(t, conn.Create(&Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice", Version: 1}).Error)
+	repo := NewCalculationRepository(conn)
+
+	require.NoError(t, repo.UpdateCalculationForUser(t.Context(), Calculation{ID: "1", Expression: "2+3", Result: "5", UpdatedBy: "alice", Version: 1}, "alice"))
+
+	revisions, err := repo.GetRevisions(t.Context(), "1")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "2+2", revisions[0].Expression, "state before the update")
+	assert.Equal(t, "alice", revisions[0].AuthorID)
+	assert.Equal(t, "2+3", revisions[1].Expression)
+}
+
+// TestRepositoryCanceledContext — запросы к БД идут с контекстом вызова,
+// и отменённый контекст их прерывает. Репозиторий в памяти ctx не смотрит.
+func TestRepositoryCanceledContext(t *testing.T) {
+	repo := NewCalculationRepository(dbtest.New(t))
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := repo.GetAllCalculations(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	err = repo.CreateCalculation(ctx, Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, repo.DeleteCalculationForUserIfVersion(ctx, "1", "alice", 1), context.Canceled)
+}
+
+func TestRepositoryList(t *testing.T) {
+	at := func(minutes int) time.Time { return testNow.Add(time.Duration(minutes) * time.Minute) }
+	calcs := []Calculation{
+		{ID: "a", Expression: "2+2", Result: "4", ResultValue: floatPtr(4), UserID: "alice", CreatedAt: at(1)},
+		{ID: "b", Expression: "1/3", Result: "1/3", ResultValue: floatPtr(1.0 / 3), UserID: "alice", CreatedAt: at(2)},
+		{ID: "c", Expression: "1/0", UserID: "alice", CreatedAt: at(3)},
+		{ID: "d", Expression: "SIN(100%)", Result: "10", ResultValue: floatPtr(10), UserID: "alice", CreatedAt: at(4)},
+		{ID: "e", Expression: "x>1", Result: "true", UserID: "alice", CreatedAt: at(5)},
+		{ID: "f", Expression: "2+2", Result: "4", ResultValue: floatPtr(4), UserID: "bob", CreatedAt: at(6)},
+	}
+
+	from := at(2)
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{"новые первыми", ListOptions{Limit: 2}, []string{"e", "d", "c", "b", "a"}},
+		{"старые первыми", ListOptions{Limit: 2, Order: OrderAsc}, []string{"a", "b", "c", "d", "e"}},
+		{"по результату", ListOptions{Limit: 2, Sort: SortResult, Order: OrderAsc}, []string{"b", "a", "d", "c", "e"}},
+		{"по результату по убыванию", ListOptions{Limit: 2, Sort: SortResult}, []string{"d", "a", "b", "e", "c"}},
+		{"подстрока без учёта регистра", ListOptions{Filter: Filter{Expression: "sin"}}, []string{"d"}},
+		{"знак процента в подстроке", ListOptions{Filter: Filter{Expression: "0%"}}, []string{"d"}},
+		{"подчёркивание — не шаблон", ListOptions{Filter: Filter{Expression: "_"}}, nil},
+		{"диапазон результата", ListOptions{Filter: Filter{ResultMin: floatPtr(1), ResultMax: floatPtr(4)}}, []string{"a"}},
+		{"с ошибкой", ListOptions{Filter: Filter{Status: StatusError}}, []string{"c"}},
+		{"с результатом", ListOptions{Limit: 3, Filter: Filter{Status: StatusSuccess}}, []string{"e", "d", "b", "a"}},
+		{"по времени создания", ListOptions{Filter: Filter{From: &from, To: &testNow}}, nil},
+		{"с момента", ListOptions{Order: OrderAsc, Filter: Filter{From: &from}}, []string{"b", "c", "d", "e"}},
+	}
+
+	forEachRepository(t, calcs, func(t *testing.T, repo CalculationRepository) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, listIDs(t, repo, "alice", false, tt.opts))
+			})
+		}
+
+		q, err := ListOptions{Order: OrderAsc}.query(false)
+		require.NoError(t, err)
+		all, err := repo.ListCalculations(t.Context(), q)
+		require.NoError(t, err)
+		assert.Len(t, all, 6, "all users")
+	})
+}
+
+func TestRepositoryTrash(t *testing.T) {
+	calcs := []Calculation{
+		{ID: "1", Expression: "1+1", Result: "2", UserID: "alice", Version: 1},
+		{ID: "2", Expression: "2+2", Result: "4", UserID: "alice", Version: 1},
+		{ID: "3", Expression: "3+3", Result: "6", UserID: "alice", Version: 1},
+	}
+
+	forEachRepository(t, calcs, func(t *testing.T, repo CalculationRepository) {
+		assert.ErrorIs(t, repo.DeleteCalculationForUser(t.Context(), "1", "bob"), gorm.ErrRecordNotFound)
+		assert.ErrorIs(t, repo.DeleteCalculationForUserIfVersion(t.Context(), "1", "alice", 2), ErrVersionMismatch)
+		require.NoError(t, repo.DeleteCalculationForUserIfVersion(t.Context(), "1", "alice", 1))
+		require.NoError(t, repo.DeleteCalculation(t.Context(), "2"))
+		assert.ErrorIs(t, repo.DeleteCalculation(t.Context(), "2"), gorm.ErrRecordNotFound, "already in the trash")
+
+		_, err := repo.GetCalculationByID(t.Context(), "1")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.ElementsMatch(t, []string{"1", "2"}, listIDs(t, repo, "alice", true, ListOptions{}))
+
+		require.NoError(t, repo.RestoreCalculationForUser(t.Context(), "2", "alice"))
+		require.NoError(t, repo.RestoreCalculation(t.Context(), "3"), "not in the trash")
+		assert.ErrorIs(t, repo.RestoreCalculationForUser(t.Context(), "1", "bob"), gorm.ErrRecordNotFound)
+		assert.Equal(t, []string{"1"}, listIDs(t, repo, "alice", true, ListOptions{}))
+
+		n, err := repo.PurgeDeleted(t.Context(), time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, n, "deleted less than an hour ago")
+
+		n, err = repo.PurgeDeleted(t.Context(), time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		assert.ErrorIs(t, repo.RestoreCalculation(t.Context(), "1"), gorm.ErrRecordNotFound)
+		revisions, err := repo.GetRevisions(t.Context(), "1")
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+
+		all, err := repo.GetAllCalculationsForUser(t.Context(), "alice")
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+}
@@ -0,0 +1,205 @@
+package calculationService
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ErrRevisionNotFound — у записи нет ревизии с таким номером.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// Revision — неизменяемый снимок записи после создания, пересчёта или
+// отката. Номера ревизий одной записи идут подряд с 1.
+type Revision struct {
+	ID            uint      `gorm:"primaryKey" json:"-"`
+	CalculationID string    `gorm:"not null;uniqueIndex:idx_revisions_calculation_number" json:"calculation_id"`
+	Number        int       `gorm:"not null;uniqueIndex:idx_revisions_calculation_number" json:"number"`
+	Expression    string    `gorm:"size:255;not null" json:"expression"`
+	Result        string    `json:"result"`
+	Unit          string    `json:"unit,omitempty"`
+	ResultType    string    `json:"result_type,omitempty"`
+	Engine        string    `json:"engine"`
+	Mode          string    `json:"mode"`
+	Precision     int       `json:"precision"`
+	WordType      string    `json:"word_type,omitempty"`
+	ComplexForm   string    `json:"complex_form,omitempty"`
+	AngleUnit     string    `json:"angle_unit"`
+	Variables     Bindings  `json:"variables,omitempty"`
+	Functions     Bindings  `json:"functions,omitempty"`
+	Rates         Bindings  `json:"rates,omitempty"`
+	AuthorID      string    `json:"author_id"`  // кто создал или изменил запись
+	CreatedAt     time.Time `json:"created_at"` // когда
+}
+
+// newRevision — снимок текущего состояния calc под номером number.
+func newRevision(calc Calculation, number int) Revision {
+	at := calc.UpdatedAt
+	if at.IsZero() {
+		at = calc.CreatedAt
+	}
+	return Revision{
+		CalculationID: calc.ID,
+		Number:        number,
+		Expression:    calc.Expression,
+		Result:        calc.Result,
+		Unit:          calc.Unit,
+		ResultType:    calc.ResultType,
+		Engine:        calc.Engine,
+		Mode:          calc.Mode,
+		Precision:     calc.Precision,
+		WordType:      calc.WordType,
+		ComplexForm:   calc.ComplexForm,
+		AngleUnit:     calc.AngleUnit,
+		Variables:     calc.Variables,
+		Functions:     calc.Functions,
+		Rates:         calc.Rates,
+		AuthorID:      calc.UpdatedBy,
+		CreatedAt:     at,
+	}
+}
+
+// apply — переносит в calc выражение, результат и параметры вычисления из ревизии.
+func (rev Revision) apply(calc *Calculation) {
+	calc.Expression = rev.Expression
+	calc.Result = rev.Result
+	calc.Unit = rev.Unit
+	calc.ResultType = rev.ResultType
+	calc.ResultValue = resultValue(rev.Result)
+	calc.Engine = rev.Engine
+	calc.Mode = rev.Mode
+	calc.Precision = rev.Precision
+	calc.WordType = rev.WordType
+	calc.ComplexForm = rev.ComplexForm
+	calc.AngleUnit = rev.AngleUnit
+	calc.Variables = rev.Variables
+	calc.Functions = rev.Functions
+	calc.Rates = rev.Rates
+}
+
+// Операции фрагментов Edit.
+const (
+	EditEqual  = "equal"
+	EditInsert = "insert"
+	EditDelete = "delete"
+)
+
+// Change — поле, которое различается в двух ревизиях. Для переменных,
+// функций и курсов валют поле называется "variables.<имя>", "functions.<имя>"
+// и "rates.<код>"; пустое значение — имени в ревизии нет.
+type Change struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Edit — фрагмент выражения: общий для двух ревизий, добавленный или удалённый.
+type Edit struct {
+	Op   string
+	Text string
+}
+
+// RevisionDiff — различия двух ревизий одной записи.
+type RevisionDiff struct {
+	From       int
+	To         int
+	Changes    []Change // в порядке полей Revision
+	Expression []Edit   // выражение From, превращённое в выражение To
+}
+
+// Diff — различия между ревизиями from и to.
+func Diff(from, to Revision) RevisionDiff {
+	d := RevisionDiff{From: from.Number, To: to.Number}
+	field := func(name, a, b string) {
+		if a != b {
+			d.Changes = append(d.Changes, Change{Field: name, From: a, To: b})
+		}
+	}
+	field("expression", from.Expression, to.Expression)
+	field("result", from.Result, to.Result)
+	field("unit", from.Unit, to.Unit)
+	field("result_type", from.ResultType, to.ResultType)
+	field("engine", from.Engine, to.Engine)
+	field("mode", from.Mode, to.Mode)
+	field("precision", strconv.Itoa(from.Precision), strconv.Itoa(to.Precision))
+	field("word_type", from.WordType, to.WordType)
+	field("complex_form", from.ComplexForm, to.ComplexForm)
+	field("angle_unit", from.AngleUnit, to.AngleUnit)
+	for _, name := range bindingNames(from.Variables, to.Variables) {
+		field("variables."+name, from.Variables[name], to.Variables[name])
+	}
+	for _, name := range bindingNames(from.Functions, to.Functions) {
+		field("functions."+name, from.Functions[name], to.Functions[name])
+	}
+	for _, name := range bindingNames(from.Rates, to.Rates) {
+		field("rates."+name, from.Rates[name], to.Rates[name])
+	}
+	d.Expression = diffTokens(diffTokenPattern.FindAllString(from.Expression, -1), diffTokenPattern.FindAllString(to.Expression, -1))
+	return d
+}
+
+// bindingNames — имена из обоих наборов по алфавиту.
+func bindingNames(a, b Bindings) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var names []string
+	for _, m := range []Bindings{a, b} {
+		for name := range m {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// diffTokenPattern — выражение для сравнения режется на имена, числа,
+// пробелы и отдельные символы; синтаксис движка при этом не важен.
+var diffTokenPattern = regexp.MustCompile(`[\p{L}_][\p{L}\p{N}_]*|[0-9.]+(?:[eE][+-]?[0-9]+)?|\s+|.`)
+
+// diffTokens — правка a в b по наибольшей общей подпоследовательности
+// токенов; соседние фрагменты с одной операцией склеиваются.
+func diffTokens(a, b []string) []Edit {
+	// lcs[i][j] — длина общей подпоследовательности a[i:] и b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var edits []Edit
+	add := func(op, text string) {
+		if n := len(edits); n > 0 && edits[n-1].Op == op {
+			edits[n-1].Text += text
+			return
+		}
+		edits = append(edits, Edit{Op: op, Text: text})
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			add(EditEqual, a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			add(EditDelete, a[i])
+			i++
+		default:
+			add(EditInsert, b[j])
+			j++
+		}
+	}
+	return edits
+}
@@ -0,0 +1,133 @@
+package calculationService
+
+import (
+	"github.com/stretchr/testify/mock"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestDiffTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []Edit
+	}{
+		{"без изменений", "2+2", "2+2", []Edit{{EditEqual, "2+2"}}},
+		{"замена числа", "2+2", "2+3", []Edit{{EditEqual, "2+"}, {EditDelete, "2"}, {EditInsert, "3"}}},
+		{"число целиком", "10*x", "100*x", []Edit{{EditDelete, "10"}, {EditInsert, "100"}, {EditEqual, "*x"}}},
+		{"добавлена функция", "x + 1", "sin(x) + 1", []Edit{{EditInsert, "sin("}, {EditEqual, "x"}, {EditInsert, ")"}, {EditEqual, " + 1"}}},
+		{"из пустого", "", "1", []Edit{{EditInsert, "1"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(Revision{Expression: tt.from}, Revision{Expression: tt.to}).Expression
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffChanges(t *testing.T) {
+	from := Revision{Number: 1, Expression: "x+1", Result: "3", Engine: DefaultEngine, Mode: ModeFloat, Variables: Bindings{"x": "2", "y": "5"}, Rates: Bindings{"EUR": "0.92"}}
+	to := Revision{Number: 3, Expression: "x+1", Result: "11", Engine: DefaultEngine, Mode: ModeRational, Variables: Bindings{"x": "10"}, Functions: Bindings{"f": "f(x) = x"}, Rates: Bindings{"EUR": "0.95"}}
+
+	d := Diff(from, to)
+	assert.Equal(t, 1, d.From)
+	assert.Equal(t, 3, d.To)
+	assert.Equal(t, []Change{
+		{Field: "result", From: "3", To: "11"},
+		{Field: "mode", From: ModeFloat, To: ModeRational},
+		{Field: "variables.x", From: "2", To: "10"},
+		{Field: "variables.y", From: "5", To: ""},
+		{Field: "functions.f", From: "", To: "f(x) = x"},
+		{Field: "rates.EUR", From: "0.92", To: "0.95"},
+	}, d.Changes)
+}
+
+func TestRevertCalculationForUser(t *testing.T) {
+	current := Calculation{ID: "1", Expression: "3*3", Result: "9", Engine: DefaultEngine, Mode: ModeFloat, UserID: "alice", UpdatedBy: "alice"}
+	first := Revision{CalculationID: "1", Number: 1, Expression: "1/3", Result: "1/3", Engine: DefaultEngine, Mode: ModeRational, AuthorID: "alice"}
+
+	tests := []struct {
+		name      string
+		requester Requester
+		number    int
+		mockSetup func(m *MockTaskRepository)
+		wantErr   error
+	}{
+		{
+			name:      "администратор откатывает чужую задачу",
+			requester: Requester{UserID: "root", Admin: true},
+			number:    1,
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByID", mock.Anything, "1").Return(current, nil)
+				m.On("GetRevision", mock.Anything, "1", 1).Return(first, nil)
+				m.On("UpdateCalculationForUser", mock.Anything, Calculation{
+					ID:          "1",
+					Expression:  "1/3",
+					Result:      "1/3",
+					ResultValue: floatPtr(1.0 / 3),
+					Engine:      DefaultEngine,
+					Mode:        ModeRational,
+					UserID:      "alice",
+					UpdatedBy:   "root",
+					UpdatedAt:   testNow,
+				}, "alice").Return(nil)
+			},
+		},
+		{
+			name:      "нет такой ревизии",
+			requester: Requester{UserID: "alice"},
+			number:    7,
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(current, nil)
+				m.On("GetRevision", mock.Anything, "1", 7).Return(Revision{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrRevisionNotFound,
+		},
+		{
+			name:      "чужая задача",
+			requester: Requester{UserID: "bob"},
+			number:    1,
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "bob").Return(Calculation{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrCalculationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			tt.mockSetup(mockRepo)
+
+			service := withClock(NewCalculationService(mockRepo))
+			calc, err := service.RevertCalculationForUser(t.Context(), "1", tt.number, tt.requester)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "1/3", calc.Expression)
+				assert.Equal(t, "alice", calc.UserID)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDiffRevisionsForUser(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(Calculation{ID: "1", UserID: "alice"}, nil)
+	mockRepo.On("GetRevision", mock.Anything, "1", 1).Return(Revision{Number: 1, Expression: "1+1", Result: "2"}, nil)
+	mockRepo.On("GetRevision", mock.Anything, "1", 2).Return(Revision{Number: 2, Expression: "1+2", Result: "3"}, nil)
+
+	service := NewCalculationService(mockRepo)
+	d, err := service.DiffRevisionsForUser(t.Context(), "1", 1, 2, Requester{UserID: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{Field: "expression", From: "1+1", To: "1+2"}, {Field: "result", From: "2", To: "3"}}, d.Changes)
+	mockRepo.AssertExpectations(t)
+}
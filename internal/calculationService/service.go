@@ -1,63 +1,250 @@
 package calculationService
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"strconv"
+	"time"
 
-	"github.com/Knetic/govaluate"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+var (
+	// ErrCalculationNotFound — запись с указанным ID не существует.
+	ErrCalculationNotFound = errors.New("calculation not found")
+	// ErrInvalidID — ID не является ни UUID, ни числовым ID старой схемы.
+	ErrInvalidID = errors.New("invalid calculation id")
+	// ErrForbidden — у запрашивающего нет прав на операцию: например,
+	// запрос без пользователя и без роли администратора.
+	ErrForbidden = errors.New("forbidden")
+	// ErrVersionMismatch — запись изменили с тех пор, как её прочитали:
+	// её версия не та, которую ожидал запрос.
+	ErrVersionMismatch = errors.New("calculation version mismatch")
+)
+
+// NormalizeID — проверяет и приводит ID записи к каноническому виду.
+// Новые записи используют UUID; числовые ID остались от старой миграции
+// с SERIAL-колонкой и принимаются как есть (без ведущих нулей).
+func NormalizeID(id string) (string, error) {
+	if parsed, err := uuid.Parse(id); err == nil {
+		return parsed.String(), nil
+	}
+	if n, err := strconv.ParseUint(id, 10, 64); err == nil {
+		return strconv.FormatUint(n, 10), nil
+	}
+	return "", ErrInvalidID
+}
+
+// notFound — переводит "запись не найдена" из GORM в ошибку сервиса.
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrCalculationNotFound
+	}
+	return err
+}
+
 // Интерфейс описывает все операции для бизнес-логики.
 type CalculationService interface {
-	CreateCalculation(expression, userID string) (Calculation, error)
-	GetAllCalculations() ([]Calculation, error)
-	GetCalculationByID(id string) (Calculation, error)
-	UpdateCalculation(id, expression string) (Calculation, error)
-	DeleteCalculation(id string) error
+	CreateCalculation(ctx context.Context, expression, userID string, opts EvalOptions) (Calculation, error)
+	GetAllCalculations(ctx context.Context) ([]Calculation, error)
+	GetCalculationByID(ctx context.Context, id string) (Calculation, error)
+	UpdateCalculation(ctx context.Context, id, expression string, opts EvalOptions) (Calculation, error)
+	DeleteCalculation(ctx context.Context, id string) error
+
+	// Варианты от имени конкретного пользователя: обычный пользователь
+	// видит и меняет только свои записи, администратор — любые.
+	GetAllCalculationsForUser(ctx context.Context, r Requester) ([]Calculation, error)
+	GetCalculationByIDForUser(ctx context.Context, id string, r Requester) (Calculation, error)
+	UpdateCalculationForUser(ctx context.Context, id, expression string, opts EvalOptions, r Requester) (Calculation, error)
+	DeleteCalculationForUser(ctx context.Context, id string, r Requester) error
+
+	// Условные варианты: запись меняется, только если её текущая версия
+	// равна version, иначе ErrVersionMismatch. Так два редактора одной
+	// записи не затирают правки друг друга.
+	UpdateCalculationIfVersion(ctx context.Context, id, expression string, opts EvalOptions, version int, r Requester) (Calculation, error)
+	DeleteCalculationIfVersion(ctx context.Context, id string, version int, r Requester) error
+
+	// ListCalculationsForUser — страница истории, доступной пользователю,
+	// с сортировкой и фильтрами opts.
+	ListCalculationsForUser(ctx context.Context, r Requester, opts ListOptions) (Page, error)
+
+	// Корзина: удалённые записи, доступные пользователю, от недавно
+	// удалённых к давним (сортировка и фильтры opts не применяются),
+	// и восстановление записи из неё.
+	ListTrashForUser(ctx context.Context, r Requester, opts ListOptions) (Page, error)
+	RestoreCalculationForUser(ctx context.Context, id string, r Requester) (Calculation, error)
+
+	// PurgeTrash — окончательно удаляет записи, пролежавшие в корзине
+	// дольше retention; возвращает число удалённых.
+	PurgeTrash(ctx context.Context, retention time.Duration) (int64, error)
+
+	// Ревизии записи, доступной пользователю: список по возрастанию номера,
+	// различия двух ревизий и откат к ревизии. Откат сам становится новой
+	// ревизией, поэтому история не теряется.
+	GetRevisionsForUser(ctx context.Context, id string, r Requester) ([]Revision, error)
+	DiffRevisionsForUser(ctx context.Context, id string, from, to int, r Requester) (RevisionDiff, error)
+	RevertCalculationForUser(ctx context.Context, id string, number int, r Requester) (Calculation, error)
+
+	// Engines — зарегистрированные движки вычислений и их возможности.
+	Engines() []EngineInfo
+}
+
+// now — текущее время с точностью, которую хранит timestamp в БД.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// Requester — тот, от чьего имени выполняется операция.
+type Requester struct {
+	UserID string // владелец записей, к которым есть доступ
+	Admin  bool   // администратор работает с записями всех пользователей
+}
+
+// check — без пользователя доступ есть только у администратора; иначе
+// пустой UserID открыл бы записи старой схемы, у которых нет владельца.
+func (r Requester) check() error {
+	if !r.Admin && r.UserID == "" {
+		return ErrForbidden
+	}
+	return nil
 }
 
 // calcService — структура, реализующая интерфейс CalculationService.
-// Здесь мы храним зависимость от репозитория.
+// Здесь мы храним зависимость от репозитория и зарегистрированные движки.
 type calcService struct {
-	repo CalculationRepository
+	repo          CalculationRepository
+	engines       map[string]Evaluator
+	defaultEngine string
+	variables     VariableSource
+	functions     FunctionSource
+	rates         RateSource
+	limits        Limits
+	now           func() time.Time // часы для CreatedAt и UpdatedAt; подменяются в тестах
 }
 
 // NewCalculationService — конструктор, создающий новый сервис.
-func NewCalculationService(repo CalculationRepository) CalculationService {
-	return &calcService{repo: repo}
+// Встроенные движки: govaluate (по умолчанию), bignum, units, programmer,
+// complex и matrix; опциями можно добавить другие или сменить движок по умолчанию.
+func NewCalculationService(repo CalculationRepository, opts ...Option) CalculationService {
+	s := &calcService{
+		repo:          repo,
+		engines:       map[string]Evaluator{},
+		defaultEngine: DefaultEngine,
+		limits:        DefaultLimits(),
+		now:           now,
+	}
+	WithEvaluator(NewGovaluateEvaluator())(s)
+	WithEvaluator(NewBignumEvaluator())(s)
+	WithEvaluator(NewUnitsEvaluator())(s)
+	WithEvaluator(NewProgrammerEvaluator())(s)
+	WithEvaluator(NewComplexEvaluator())(s)
+	WithEvaluator(NewMatrixEvaluator())(s)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // calculateExpression — вспомогательная функция для вычислений.
-// Принимает строку (например, "2+2"), возвращает результат ("4").
-func (s *calcService) calculateExpression(expression string) (string, error) {
-	expr, err := govaluate.NewEvaluableExpression(expression)
+// Берёт выражение из calc (например, "price*(1+tax)"), вычисляет его
+// с переменными и функциями владельца записи и заполняет результат и
+// параметры, с которыми он получен: движок, режим, точность, значения
+// переменных и определения вызванных функций. Выражение и результат
+// проверяются на s.limits; вычисление прерывается по Limits.Timeout
+// или отмене ctx. Валюты пересчитываются по курсам на calc.UpdatedAt, а
+// использованные курсы сохраняются в записи, чтобы результат можно было
+// проверить и повторить позже.
+func (s *calcService) calculateExpression(ctx context.Context, calc *Calculation, opts EvalOptions) error {
+	if err := s.limits.checkLength(calc.Expression); err != nil {
+		return err
+	}
+
+	engine, env, err := s.resolve(opts)
 	if err != nil {
-		return "", err // Ошибка при создании выражения
+		return err
 	}
 
-	result, err := expr.Evaluate(nil)
+	env.Variables, err = s.scope(ctx, calc.UserID)
 	if err != nil {
-		return "", err // Ошибка при вычислении
+		return err
+	}
+	if env.Rates, err = s.ratesAt(ctx, env, calc.UpdatedAt); err != nil {
+		return err
+	}
+	var defs []FunctionDefinition
+	if s.functions != nil && calc.UserID != "" {
+		if defs, err = s.functions.FunctionsForUser(ctx, calc.UserID); err != nil {
+			return err
+		}
 	}
 
-	return fmt.Sprintf("%v", result), nil
-}
+	// Вычисление прерывается и по Limits.Timeout, и вместе с ctx запроса;
+	// причина ErrTimeout отличает первое от срока самого запроса.
+	if s.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, s.limits.Timeout, ErrTimeout)
+		defer cancel()
+	}
+	env.ctx = ctx // функции пользователя вычисляются в этом окружении
+	userFuncs, err := compileFunctions(defs, &env)
+	if err != nil {
+		return err
+	}
 
-// CreateCalculation — создаёт новую запись: вычисляет и сохраняет результат.
-func (s *calcService) CreateCalculation(expression, userID string) (Calculation, error) {
-	result, err := s.calculateExpression(expression)
+	program, err := engine.Parse(calc.Expression, env)
 	if err != nil {
-		return Calculation{}, err
+		return err
 	}
 
+	used, err := bind(program, env.Variables)
+	if err != nil {
+		return err
+	}
+
+	evaluation, err := engine.Evaluate(ctx, program, env)
+	if err != nil {
+		return err
+	}
+	if err := s.limits.checkResult(evaluation.Result); err != nil {
+		return err
+	}
+
+	calc.Result = evaluation.Result
+	calc.Unit = evaluation.Unit
+	calc.ResultType = evaluation.Type
+	if calc.ResultType == "" {
+		calc.ResultType = ResultNumber
+	}
+	calc.Rates = evaluation.Rates
+	calc.ResultValue = resultValue(evaluation.Result)
+	calc.Engine = engine.Name()
+	calc.Mode = env.Mode
+	calc.Precision = env.Precision
+	calc.WordType = env.WordType
+	calc.ComplexForm = env.ComplexForm
+	calc.AngleUnit = env.AngleUnit
+	calc.Variables = userFuncs.bindVariables(used, env.Variables)
+	calc.Functions = userFuncs.bindings()
+	return nil
+}
+
+// CreateCalculation — создаёт новую запись: вычисляет и сохраняет результат.
+func (s *calcService) CreateCalculation(ctx context.Context, expression, userID string, opts EvalOptions) (Calculation, error) {
 	calc := Calculation{
 		ID:         uuid.NewString(),
 		Expression: expression,
-		Result:     result,
 		UserID:     userID,
+		UpdatedBy:  userID,
+		Version:    1,
+		CreatedAt:  s.now(),
+	}
+	calc.UpdatedAt = calc.CreatedAt
+	if err := s.calculateExpression(ctx, &calc, opts); err != nil {
+		return Calculation{}, err
 	}
 
-	if err := s.repo.CreateCalculation(calc); err != nil {
+	if err := s.repo.CreateCalculation(ctx, calc); err != nil {
 		return Calculation{}, err
 	}
 
@@ -65,36 +252,255 @@ func (s *calcService) CreateCalculation(expression, userID string) (Calculation,
 }
 
 // GetAllCalculations — возвращает все записи из БД.
-func (s *calcService) GetAllCalculations() ([]Calculation, error) {
-	return s.repo.GetAllCalculations()
+func (s *calcService) GetAllCalculations(ctx context.Context) ([]Calculation, error) {
+	return s.repo.GetAllCalculations(ctx)
 }
 
 // GetCalculationByID — возвращает конкретную запись по ID.
-func (s *calcService) GetCalculationByID(id string) (Calculation, error) {
-	return s.repo.GetCalculationByID(id)
+func (s *calcService) GetCalculationByID(ctx context.Context, id string) (Calculation, error) {
+	calc, err := s.repo.GetCalculationByID(ctx, id)
+	return calc, notFound(err)
 }
 
 // UpdateCalculation — пересчитывает выражение и обновляет запись в БД.
-func (s *calcService) UpdateCalculation(id, expression string) (Calculation, error) {
-	result, err := s.calculateExpression(expression)
+// Владелец и время создания остаются прежними; автор изменения неизвестен.
+func (s *calcService) UpdateCalculation(ctx context.Context, id, expression string, opts EvalOptions) (Calculation, error) {
+	calc, err := s.GetCalculationByID(ctx, id)
 	if err != nil {
 		return Calculation{}, err
 	}
+	calc.Expression = expression
+	calc.UpdatedAt = s.now()
+	calc.UpdatedBy = ""
+	if err := s.calculateExpression(ctx, &calc, opts); err != nil {
+		return Calculation{}, err
+	}
 
-	calc := Calculation{
-		ID:         id,
-		Expression: expression,
-		Result:     result,
+	if err := s.repo.UpdateCalculation(ctx, calc); err != nil {
+		return Calculation{}, notFound(err)
+	}
+
+	calc.Version++
+	return calc, nil
+}
+
+// DeleteCalculation — переносит запись в корзину по ID.
+func (s *calcService) DeleteCalculation(ctx context.Context, id string) error {
+	return notFound(s.repo.DeleteCalculation(ctx, id))
+}
+
+// GetAllCalculationsForUser — возвращает записи, доступные пользователю.
+func (s *calcService) GetAllCalculationsForUser(ctx context.Context, r Requester) ([]Calculation, error) {
+	if err := r.check(); err != nil {
+		return nil, err
 	}
+	if r.Admin {
+		return s.repo.GetAllCalculations(ctx)
+	}
+	return s.repo.GetAllCalculationsForUser(ctx, r.UserID)
+}
 
-	if err := s.repo.UpdateCalculation(calc); err != nil {
+// GetCalculationByIDForUser — возвращает запись, если она доступна пользователю.
+// Чужая запись даёт ErrCalculationNotFound: не раскрываем, что такой ID существует.
+func (s *calcService) GetCalculationByIDForUser(ctx context.Context, id string, r Requester) (Calculation, error) {
+	if err := r.check(); err != nil {
 		return Calculation{}, err
 	}
+	if r.Admin {
+		return s.GetCalculationByID(ctx, id)
+	}
+	calc, err := s.repo.GetCalculationByIDForUser(ctx, id, r.UserID)
+	return calc, notFound(err)
+}
 
-	return calc, nil
+// UpdateCalculationForUser — пересчитывает и обновляет запись, доступную пользователю.
+// Если ни движок, ни режим не указаны, запись пересчитывается с прежними
+// движком, режимом и точностью; если не указаны единицы углов — с прежними.
+func (s *calcService) UpdateCalculationForUser(ctx context.Context, id, expression string, opts EvalOptions, r Requester) (Calculation, error) {
+	return s.update(ctx, id, expression, opts, 0, r)
+}
+
+// UpdateCalculationIfVersion — как UpdateCalculationForUser, но только для записи версии version.
+func (s *calcService) UpdateCalculationIfVersion(ctx context.Context, id, expression string, opts EvalOptions, version int, r Requester) (Calculation, error) {
+	return s.update(ctx, id, expression, opts, version, r)
 }
 
-// DeleteCalculation — удаляет запись по ID.
-func (s *calcService) DeleteCalculation(id string) error {
-	return s.repo.DeleteCalculation(id)
+// update — пересчитывает и обновляет запись, доступную r; version 0 — любой версии.
+func (s *calcService) update(ctx context.Context, id, expression string, opts EvalOptions, version int, r Requester) (Calculation, error) {
+	existing, err := s.GetCalculationByIDForUser(ctx, id, r)
+	if err != nil {
+		return Calculation{}, err
+	}
+	if version != 0 && existing.Version != version {
+		return Calculation{}, ErrVersionMismatch
+	}
+
+	if opts.Engine == "" && opts.Mode == "" {
+		opts.Engine, opts.Mode, opts.Precision = existing.Engine, existing.Mode, existing.Precision
+		if opts.WordType == "" {
+			opts.WordType = existing.WordType
+		}
+		if opts.ComplexForm == "" {
+			opts.ComplexForm = existing.ComplexForm
+		}
+	}
+	if opts.AngleUnit == "" {
+		opts.AngleUnit = existing.AngleUnit
+	}
+	existing.Expression = expression
+	existing.UpdatedAt = s.now()
+	existing.UpdatedBy = r.UserID
+	if err := s.calculateExpression(ctx, &existing, opts); err != nil {
+		return Calculation{}, err
+	}
+
+	// Владелец не меняется, даже если запись правит администратор.
+	if err := s.repo.UpdateCalculationForUser(ctx, existing, existing.UserID); err != nil {
+		return Calculation{}, notFound(err)
+	}
+
+	existing.Version++
+	return existing, nil
+}
+
+// DeleteCalculationForUser — переносит в корзину запись, доступную пользователю.
+func (s *calcService) DeleteCalculationForUser(ctx context.Context, id string, r Requester) error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	if r.Admin {
+		return s.DeleteCalculation(ctx, id)
+	}
+	return notFound(s.repo.DeleteCalculationForUser(ctx, id, r.UserID))
+}
+
+// DeleteCalculationIfVersion — как DeleteCalculationForUser, но только для записи версии version.
+func (s *calcService) DeleteCalculationIfVersion(ctx context.Context, id string, version int, r Requester) error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	if r.Admin {
+		return notFound(s.repo.DeleteCalculationIfVersion(ctx, id, version))
+	}
+	return notFound(s.repo.DeleteCalculationForUserIfVersion(ctx, id, r.UserID, version))
+}
+
+// ListCalculationsForUser — страница записей, доступных пользователю:
+// своих для обычного пользователя, всех для администратора.
+func (s *calcService) ListCalculationsForUser(ctx context.Context, r Requester, opts ListOptions) (Page, error) {
+	if err := r.check(); err != nil {
+		return Page{}, err
+	}
+	q, err := opts.query(false)
+	if err != nil {
+		return Page{}, err
+	}
+	return s.list(ctx, r, q)
+}
+
+// list — страница по запросу q из записей, доступных r.
+func (s *calcService) list(ctx context.Context, r Requester, q ListQuery) (Page, error) {
+	var calcs []Calculation
+	var err error
+	if r.Admin {
+		calcs, err = s.repo.ListCalculations(ctx, q)
+	} else {
+		calcs, err = s.repo.ListCalculationsForUser(ctx, q, r.UserID)
+	}
+	if err != nil {
+		return Page{}, err
+	}
+	return q.page(calcs), nil
+}
+
+// ListTrashForUser — страница корзины: удалённые записи пользователя или,
+// для администратора, всех пользователей.
+func (s *calcService) ListTrashForUser(ctx context.Context, r Requester, opts ListOptions) (Page, error) {
+	if err := r.check(); err != nil {
+		return Page{}, err
+	}
+	q, err := ListOptions{Limit: opts.Limit, Cursor: opts.Cursor}.query(true)
+	if err != nil {
+		return Page{}, err
+	}
+	return s.list(ctx, r, q)
+}
+
+// RestoreCalculationForUser — возвращает запись из корзины. Запись не из
+// корзины возвращается как есть; чужая — ErrCalculationNotFound.
+func (s *calcService) RestoreCalculationForUser(ctx context.Context, id string, r Requester) (Calculation, error) {
+	if err := r.check(); err != nil {
+		return Calculation{}, err
+	}
+	var err error
+	if r.Admin {
+		err = s.repo.RestoreCalculation(ctx, id)
+	} else {
+		err = s.repo.RestoreCalculationForUser(ctx, id, r.UserID)
+	}
+	if err != nil {
+		return Calculation{}, notFound(err)
+	}
+	return s.GetCalculationByIDForUser(ctx, id, r)
+}
+
+// PurgeTrash — окончательно удаляет записи, удалённые раньше, чем retention назад.
+func (s *calcService) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.repo.PurgeDeleted(ctx, s.now().Add(-retention))
+}
+
+// GetRevisionsForUser — ревизии записи, доступной пользователю.
+func (s *calcService) GetRevisionsForUser(ctx context.Context, id string, r Requester) ([]Revision, error) {
+	if _, err := s.GetCalculationByIDForUser(ctx, id, r); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRevisions(ctx, id)
+}
+
+// DiffRevisionsForUser — различия ревизий from и to записи, доступной пользователю.
+func (s *calcService) DiffRevisionsForUser(ctx context.Context, id string, from, to int, r Requester) (RevisionDiff, error) {
+	if _, err := s.GetCalculationByIDForUser(ctx, id, r); err != nil {
+		return RevisionDiff{}, err
+	}
+	a, err := s.revision(ctx, id, from)
+	if err != nil {
+		return RevisionDiff{}, err
+	}
+	b, err := s.revision(ctx, id, to)
+	if err != nil {
+		return RevisionDiff{}, err
+	}
+	return Diff(a, b), nil
+}
+
+// RevertCalculationForUser — возвращает запись к ревизии number. Сохранённый
+// результат ревизии не пересчитывается: откат восстанавливает запись такой,
+// какой она была.
+func (s *calcService) RevertCalculationForUser(ctx context.Context, id string, number int, r Requester) (Calculation, error) {
+	existing, err := s.GetCalculationByIDForUser(ctx, id, r)
+	if err != nil {
+		return Calculation{}, err
+	}
+	rev, err := s.revision(ctx, id, number)
+	if err != nil {
+		return Calculation{}, err
+	}
+
+	rev.apply(&existing)
+	existing.UpdatedAt = s.now()
+	existing.UpdatedBy = r.UserID
+	if err := s.repo.UpdateCalculationForUser(ctx, existing, existing.UserID); err != nil {
+		return Calculation{}, notFound(err)
+	}
+	existing.Version++
+	return existing, nil
+}
+
+// revision — ревизия number записи id; отсутствующая — ErrRevisionNotFound.
+func (s *calcService) revision(ctx context.Context, id string, number int) (Revision, error) {
+	rev, err := s.repo.GetRevision(ctx, id, number)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Revision{}, ErrRevisionNotFound
+	}
+	return rev, err
 }
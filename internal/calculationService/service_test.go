@@ -1,13 +1,25 @@
 package calculationService
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
+// testNow — время часов сервиса в тестах, сравнивающих записи целиком.
+var testNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// withClock — подменяет часы сервиса на testNow.
+func withClock(s CalculationService) CalculationService {
+	s.(*calcService).now = func() time.Time { return testNow }
+	return s
+}
+
 func TestCreateCalculation(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -19,7 +31,7 @@ func TestCreateCalculation(t *testing.T) {
 			name:  "успешное создание задачи",
 			input: "10+5",
 			mockSetup: func(m *MockTaskRepository, input string) {
-				m.On("CreateCalculation", mock.MatchedBy(func(c Calculation) bool {
+				m.On("CreateCalculation", mock.Anything, mock.MatchedBy(func(c Calculation) bool {
 					return c.Expression == input && c.Result == "15" && c.ID != ""
 				})).Return(nil)
 			},
@@ -29,7 +41,7 @@ func TestCreateCalculation(t *testing.T) {
 			name:  "ошибка при создании",
 			input: "20*2",
 			mockSetup: func(m *MockTaskRepository, input string) {
-				m.On("CreateCalculation", mock.MatchedBy(func(c Calculation) bool {
+				m.On("CreateCalculation", mock.Anything, mock.MatchedBy(func(c Calculation) bool {
 					return c.Expression == input && c.Result == "40" && c.ID != ""
 				})).Return(errors.New("db error"))
 			},
@@ -49,7 +61,7 @@ func TestCreateCalculation(t *testing.T) {
 			tt.mockSetup(mockRepo, tt.input)
 
 			service := NewCalculationService(mockRepo)
-			result, err := service.CreateCalculation(tt.input, "")
+			result, err := service.CreateCalculation(t.Context(), tt.input, "", EvalOptions{})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -74,7 +86,7 @@ func TestGetAllCalculations(t *testing.T) {
 		{
 			name: "успешное получение всех задач",
 			mockSetup: func(m *MockTaskRepository) {
-				m.On("GetAllCalculations").Return([]Calculation{
+				m.On("GetAllCalculations", mock.Anything).Return([]Calculation{
 					{ID: "1", Expression: "10+5", Result: "15"},
 					{ID: "2", Expression: "20*2", Result: "40"},
 				}, nil)
@@ -85,7 +97,7 @@ func TestGetAllCalculations(t *testing.T) {
 		{
 			name: "ошибка при получении",
 			mockSetup: func(m *MockTaskRepository) {
-				m.On("GetAllCalculations").Return(nil, errors.New("db error"))
+				m.On("GetAllCalculations", mock.Anything).Return(nil, errors.New("db error"))
 			},
 			wantErr:   true,
 			wantCount: 0,
@@ -98,7 +110,7 @@ func TestGetAllCalculations(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			service := NewCalculationService(mockRepo)
-			result, err := service.GetAllCalculations()
+			result, err := service.GetAllCalculations(t.Context())
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -125,23 +137,50 @@ func TestUpdateCalculation(t *testing.T) {
 			id:         "1",
 			expression: "100+50",
 			mockSetup: func(m *MockTaskRepository, id, expression string) {
-				m.On("UpdateCalculation", Calculation{
-					ID:         id,
-					Expression: expression,
-					Result:     "150",
+				m.On("GetCalculationByID", mock.Anything, id).Return(Calculation{ID: id, Expression: "1", Result: "1", UserID: "alice", UpdatedBy: "alice", CreatedAt: testNow.Add(-time.Hour)}, nil)
+				m.On("UpdateCalculation", mock.Anything, Calculation{
+					ID:          id,
+					Expression:  expression,
+					Result:      "150",
+					ResultValue: floatPtr(150),
+					UserID:      "alice",
+					CreatedAt:   testNow.Add(-time.Hour),
+					UpdatedAt:   testNow,
+					ResultType:  ResultNumber,
+					Engine:      DefaultEngine,
+					Mode:        ModeFloat,
+					AngleUnit:   AngleRadians,
 				}).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name:       "задача не найдена",
+			id:         "3",
+			expression: "1+1",
+			mockSetup: func(m *MockTaskRepository, id, expression string) {
+				m.On("GetCalculationByID", mock.Anything, id).Return(Calculation{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+		},
 		{
 			name:       "ошибка при обновлении",
 			id:         "2",
 			expression: "50-10",
 			mockSetup: func(m *MockTaskRepository, id, expression string) {
-				m.On("UpdateCalculation", Calculation{
-					ID:         id,
-					Expression: expression,
-					Result:     "40",
+				m.On("GetCalculationByID", mock.Anything, id).Return(Calculation{ID: id, Expression: "1", Result: "1", UserID: "alice", UpdatedBy: "alice", CreatedAt: testNow.Add(-time.Hour)}, nil)
+				m.On("UpdateCalculation", mock.Anything, Calculation{
+					ID:          id,
+					Expression:  expression,
+					Result:      "40",
+					ResultValue: floatPtr(40),
+					UserID:      "alice",
+					CreatedAt:   testNow.Add(-time.Hour),
+					UpdatedAt:   testNow,
+					ResultType:  ResultNumber,
+					Engine:      DefaultEngine,
+					Mode:        ModeFloat,
+					AngleUnit:   AngleRadians,
 				}).Return(errors.New("db error"))
 			},
 			wantErr: true,
@@ -153,8 +192,8 @@ func TestUpdateCalculation(t *testing.T) {
 			mockRepo := new(MockTaskRepository)
 			tt.mockSetup(mockRepo, tt.id, tt.expression)
 
-			service := NewCalculationService(mockRepo)
-			result, err := service.UpdateCalculation(tt.id, tt.expression)
+			service := withClock(NewCalculationService(mockRepo))
+			result, err := service.UpdateCalculation(t.Context(), tt.id, tt.expression, EvalOptions{})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -180,7 +219,7 @@ func TestDeleteCalculation(t *testing.T) {
 			name: "успешное удаление задачи",
 			id:   "1",
 			mockSetup: func(m *MockTaskRepository, id string) {
-				m.On("DeleteCalculation", id).Return(nil)
+				m.On("DeleteCalculation", mock.Anything, id).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -188,7 +227,7 @@ func TestDeleteCalculation(t *testing.T) {
 			name: "ошибка при удалении",
 			id:   "2",
 			mockSetup: func(m *MockTaskRepository, id string) {
-				m.On("DeleteCalculation", id).Return(errors.New("db error"))
+				m.On("DeleteCalculation", mock.Anything, id).Return(errors.New("db error"))
 			},
 			wantErr: true,
 		},
@@ -200,7 +239,7 @@ func TestDeleteCalculation(t *testing.T) {
 			tt.mockSetup(mockRepo, tt.id)
 
 			service := NewCalculationService(mockRepo)
-			err := service.DeleteCalculation(tt.id)
+			err := service.DeleteCalculation(t.Context(), tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -212,3 +251,359 @@ func TestDeleteCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{name: "UUID", id: "6F9619FF-8B86-D011-B42D-00CF4FC964FF", want: "6f9619ff-8b86-d011-b42d-00cf4fc964ff"},
+		{name: "числовой ID старой схемы", id: "0042", want: "42"},
+		{name: "мусор", id: "abc", wantErr: true},
+		{name: "отрицательное число", id: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeID(tt.id)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidID)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetCalculationByIDForUser(t *testing.T) {
+	owned := Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice"}
+
+	tests := []struct {
+		name      string
+		requester Requester
+		mockSetup func(m *MockTaskRepository)
+		wantErr   error
+	}{
+		{
+			name:      "своя запись",
+			requester: Requester{UserID: "alice"},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(owned, nil)
+			},
+		},
+		{
+			name:      "чужая запись выглядит как несуществующая",
+			requester: Requester{UserID: "bob"},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "bob").Return(Calculation{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrCalculationNotFound,
+		},
+		{
+			name:      "администратор видит любую запись",
+			requester: Requester{UserID: "root", Admin: true},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByID", mock.Anything, "1").Return(owned, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			tt.mockSetup(mockRepo)
+
+			service := NewCalculationService(mockRepo)
+			result, err := service.GetCalculationByIDForUser(t.Context(), "1", tt.requester)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, owned, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateCalculationForUserKeepsOwner(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("GetCalculationByID", mock.Anything, "1").Return(Calculation{ID: "1", Expression: "2+2", Result: "4", Engine: DefaultEngine, UserID: "alice"}, nil)
+	mockRepo.On("UpdateCalculationForUser", mock.Anything, Calculation{ID: "1", Expression: "3*3", Result: "9", ResultType: ResultNumber, ResultValue: floatPtr(9), Engine: DefaultEngine, Mode: ModeFloat, AngleUnit: AngleRadians, UserID: "alice", UpdatedBy: "root", UpdatedAt: testNow}, "alice").Return(nil)
+
+	service := withClock(NewCalculationService(mockRepo))
+	result, err := service.UpdateCalculationForUser(t.Context(), "1", "3*3", EvalOptions{}, Requester{UserID: "root", Admin: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", result.UserID)
+	assert.Equal(t, "root", result.UpdatedBy)
+	mockRepo.AssertExpectations(t)
+}
+
+// constEvaluator — движок-заглушка, который на любое выражение отвечает одним и тем же
+type constEvaluator struct{ result string }
+
+func (e constEvaluator) Name() string               { return "const" }
+func (e constEvaluator) Capabilities() Capabilities { return Capabilities{} }
+
+func (e constEvaluator) Parse(expression string, env Env) (Program, error) {
+	return &govaluateProgram{source: expression}, nil
+}
+
+func (e constEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	return Evaluation{Result: e.result}, nil
+}
+
+func TestCreateCalculationEngineSelection(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []Option
+		engine     string
+		wantResult string
+		wantEngine string
+		wantErr    error
+	}{
+		{name: "движок по умолчанию", wantResult: "4", wantEngine: DefaultEngine},
+		{name: "движок из запроса", opts: []Option{WithEvaluator(constEvaluator{"42"})}, engine: "const", wantResult: "42", wantEngine: "const"},
+		{name: "другой движок по умолчанию", opts: []Option{WithEvaluator(constEvaluator{"42"}), WithDefaultEngine("const")}, wantResult: "42", wantEngine: "const"},
+		{name: "неизвестный движок", engine: "nope", wantErr: ErrUnknownEngine},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateCalculation", mock.Anything, mock.MatchedBy(func(c Calculation) bool {
+					return c.Result == tt.wantResult && c.Engine == tt.wantEngine
+				})).Return(nil)
+			}
+
+			service := NewCalculationService(mockRepo, tt.opts...)
+			result, err := service.CreateCalculation(t.Context(), "2+2", "", EvalOptions{Engine: tt.engine})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantEngine, result.Engine)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// staticVariables — хранилище переменных с фиксированным набором значений
+type staticVariables map[string]string
+
+func (v staticVariables) VariablesForUser(_ context.Context, userID string) (map[string]string, error) {
+	return v, nil
+}
+
+func TestCreateCalculationWithVariables(t *testing.T) {
+	tests := []struct {
+		name          string
+		expression    string
+		opts          EvalOptions
+		wantResult    string
+		wantVariables Bindings
+		wantErr       error
+	}{
+		{name: "переменные пользователя", expression: "price*(1+tax)", wantResult: "120", wantVariables: Bindings{"price": "100", "tax": "0.2"}},
+		{name: "точный режим", expression: "price*(1+tax)", opts: EvalOptions{Mode: ModeRational}, wantResult: "120", wantVariables: Bindings{"price": "100", "tax": "0.2"}},
+		{name: "константа", expression: "2*pi", opts: EvalOptions{Mode: ModeDecimal, Precision: 10}, wantResult: "6.283185307", wantVariables: Bindings{"pi": constants["pi"].Value}},
+		{name: "без переменных", expression: "1+1", wantResult: "2"},
+		{name: "неизвестная переменная", expression: "price*discount", wantErr: ErrUnknownVariable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateCalculation", mock.Anything, mock.MatchedBy(func(c Calculation) bool {
+					return c.Result == tt.wantResult && assert.ObjectsAreEqual(tt.wantVariables, c.Variables)
+				})).Return(nil)
+			}
+
+			service := NewCalculationService(mockRepo, WithVariableSource(staticVariables{"price": "100", "tax": "0.2"}))
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "alice", tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResult, result.Result)
+				assert.Equal(t, tt.wantVariables, result.Variables)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCalculationErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		opts       EvalOptions
+		wantErr    error
+		wantOffset int
+		wantToken  string
+	}{
+		{name: "синтаксис govaluate", expression: "2 + * 3", wantOffset: 4, wantToken: "*"},
+		{name: "синтаксис bignum", expression: "2 + (3", opts: EvalOptions{Mode: ModeRational}, wantOffset: 6},
+		{name: "неизвестная переменная", expression: "2 * rate", wantErr: ErrUnknownVariable, wantOffset: 4, wantToken: "rate"},
+		{name: "неизвестная функция", expression: "1 + foo(2)", opts: EvalOptions{Mode: ModeDecimal}, wantErr: ErrUnknownFunction, wantOffset: 4, wantToken: "foo"},
+		{name: "деление на ноль во float", expression: "1 / (2 - 2)", wantErr: ErrDivisionByZero, wantOffset: -1},
+		{name: "деление на ноль в точном режиме", expression: "1 / 0", opts: EvalOptions{Mode: ModeRational}, wantErr: ErrDivisionByZero, wantOffset: -1},
+		{name: "переполнение", expression: "exp(800) * 10", wantErr: ErrOverflow, wantOffset: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewCalculationService(new(MockTaskRepository))
+			_, err := service.CreateCalculation(t.Context(), tt.expression, "alice", tt.opts)
+
+			var syntaxErr *SyntaxError
+			var nameErr *NameError
+			switch {
+			case tt.wantErr == nil:
+				if assert.ErrorAs(t, err, &syntaxErr) {
+					assert.Equal(t, tt.wantOffset, syntaxErr.Offset)
+					assert.Equal(t, tt.wantToken, syntaxErr.Token)
+				}
+			case tt.wantOffset >= 0:
+				assert.ErrorIs(t, err, tt.wantErr)
+				if assert.ErrorAs(t, err, &nameErr) {
+					assert.Equal(t, tt.wantOffset, nameErr.Offset)
+					assert.Equal(t, tt.wantToken, nameErr.Name)
+				}
+			default:
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequesterWithoutUserIsForbidden(t *testing.T) {
+	service := NewCalculationService(new(MockTaskRepository))
+
+	_, err := service.GetAllCalculationsForUser(t.Context(), Requester{})
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	_, err = service.GetCalculationByIDForUser(t.Context(), "1", Requester{})
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	assert.ErrorIs(t, service.DeleteCalculationForUser(t.Context(), "1", Requester{}), ErrForbidden)
+}
+
+func TestUpdateCalculationIfVersion(t *testing.T) {
+	current := Calculation{ID: "1", Expression: "2+2", Result: "4", Engine: DefaultEngine, UserID: "alice", Version: 3}
+
+	tests := []struct {
+		name      string
+		version   int
+		mockSetup func(m *MockTaskRepository)
+		want      int
+		wantErr   error
+	}{
+		{
+			name:    "версия совпадает",
+			version: 3,
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(current, nil)
+				m.On("UpdateCalculationForUser", mock.Anything, mock.MatchedBy(func(c Calculation) bool { return c.Version == 3 }), "alice").Return(nil)
+			},
+			want: 4,
+		},
+		{
+			name:    "запись уже изменили",
+			version: 2,
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(current, nil)
+			},
+			wantErr: ErrVersionMismatch,
+		},
+		{
+			name:    "запись изменили во время пересчёта",
+			version: 3,
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(current, nil)
+				m.On("UpdateCalculationForUser", mock.Anything, mock.Anything, "alice").Return(ErrVersionMismatch)
+			},
+			wantErr: ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			tt.mockSetup(mockRepo)
+
+			service := withClock(NewCalculationService(mockRepo))
+			calc, err := service.UpdateCalculationIfVersion(t.Context(), "1", "3*3", EvalOptions{}, tt.version, Requester{UserID: "alice"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, calc.Version)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteCalculationIfVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		requester Requester
+		mockSetup func(m *MockTaskRepository)
+		wantErr   error
+	}{
+		{
+			name:      "владелец",
+			requester: Requester{UserID: "alice"},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("DeleteCalculationForUserIfVersion", mock.Anything, "1", "alice", 2).Return(nil)
+			},
+		},
+		{
+			name:      "администратор, версия устарела",
+			requester: Requester{UserID: "root", Admin: true},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("DeleteCalculationIfVersion", mock.Anything, "1", 2).Return(ErrVersionMismatch)
+			},
+			wantErr: ErrVersionMismatch,
+		},
+		{
+			name:      "чужая задача",
+			requester: Requester{UserID: "bob"},
+			mockSetup: func(m *MockTaskRepository) {
+				m.On("DeleteCalculationForUserIfVersion", mock.Anything, "1", "bob", 2).Return(gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrCalculationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			tt.mockSetup(mockRepo)
+
+			service := NewCalculationService(mockRepo)
+			err := service.DeleteCalculationIfVersion(t.Context(), "1", 2, tt.requester)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package calculationService
 
 import (
+	"context"
+	"time"
+
 	"github.com/stretchr/testify/mock"
 )
 
@@ -9,30 +12,107 @@ type MockTaskRepository struct {
 	mock.Mock
 }
 
-func (m *MockTaskRepository) CreateCalculation(task Calculation) error {
-	args := m.Called(task)
+func (m *MockTaskRepository) CreateCalculation(ctx context.Context, task Calculation) error {
+	args := m.Called(ctx, task)
 	return args.Error(0)
 }
 
-func (m *MockTaskRepository) GetAllCalculations() ([]Calculation, error) {
-	args := m.Called()
+func (m *MockTaskRepository) GetAllCalculations(ctx context.Context) ([]Calculation, error) {
+	args := m.Called(ctx)
 	if res := args.Get(0); res != nil {
 		return res.([]Calculation), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
-func (m *MockTaskRepository) GetCalculationByID(id string) (Calculation, error) {
-	args := m.Called(id)
+func (m *MockTaskRepository) GetCalculationByID(ctx context.Context, id string) (Calculation, error) {
+	args := m.Called(ctx, id)
 	return args.Get(0).(Calculation), args.Error(1)
 }
 
-func (m *MockTaskRepository) UpdateCalculation(task Calculation) error {
-	args := m.Called(task)
+func (m *MockTaskRepository) UpdateCalculation(ctx context.Context, task Calculation) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) DeleteCalculation(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockTaskRepository) DeleteCalculation(id string) error {
-	args := m.Called(id)
+func (m *MockTaskRepository) GetAllCalculationsForUser(ctx context.Context, userID string) ([]Calculation, error) {
+	args := m.Called(ctx, userID)
+	if res := args.Get(0); res != nil {
+		return res.([]Calculation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTaskRepository) GetCalculationByIDForUser(ctx context.Context, id, userID string) (Calculation, error) {
+	args := m.Called(ctx, id, userID)
+	return args.Get(0).(Calculation), args.Error(1)
+}
+
+func (m *MockTaskRepository) UpdateCalculationForUser(ctx context.Context, task Calculation, userID string) error {
+	args := m.Called(ctx, task, userID)
 	return args.Error(0)
 }
+
+func (m *MockTaskRepository) DeleteCalculationForUser(ctx context.Context, id, userID string) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) DeleteCalculationIfVersion(ctx context.Context, id string, version int) error {
+	args := m.Called(ctx, id, version)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) DeleteCalculationForUserIfVersion(ctx context.Context, id, userID string, version int) error {
+	args := m.Called(ctx, id, userID, version)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) ListCalculations(ctx context.Context, q ListQuery) ([]Calculation, error) {
+	args := m.Called(ctx, q)
+	if res := args.Get(0); res != nil {
+		return res.([]Calculation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTaskRepository) ListCalculationsForUser(ctx context.Context, q ListQuery, userID string) ([]Calculation, error) {
+	args := m.Called(ctx, q, userID)
+	if res := args.Get(0); res != nil {
+		return res.([]Calculation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTaskRepository) RestoreCalculation(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) RestoreCalculationForUser(ctx context.Context, id, userID string) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) GetRevisions(ctx context.Context, calculationID string) ([]Revision, error) {
+	args := m.Called(ctx, calculationID)
+	if res := args.Get(0); res != nil {
+		return res.([]Revision), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTaskRepository) GetRevision(ctx context.Context, calculationID string, number int) (Revision, error) {
+	args := m.Called(ctx, calculationID, number)
+	return args.Get(0).(Revision), args.Error(1)
+}
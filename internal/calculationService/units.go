@@ -0,0 +1,380 @@
+package calculationService
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrDimensionMismatch — величины несовместимых размерностей сложены,
+	// сравнены или переведены друг в друга (метры плюс секунды), или
+	// размеры векторов и матриц не подходят для операции (2x2 * 3).
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+	// ErrUnknownUnit — в переводе "to ..." указана неизвестная единица.
+	ErrUnknownUnit = errors.New("unknown unit")
+)
+
+// baseCount — число базовых размерностей: семь размерностей СИ (длина, масса,
+// время, ток, температура, количество вещества, сила света) и деньги.
+const baseCount = 8
+
+// dimension — степени базовых размерностей; нулевая — безразмерная величина.
+type dimension [baseCount]int
+
+var dimensionNames = [baseCount]string{"length", "mass", "time", "current", "temperature", "amount", "luminosity", "currency"}
+
+func (d dimension) add(o dimension, k int) dimension {
+	for i := range d {
+		d[i] += o[i] * k
+	}
+	return d
+}
+
+func (d dimension) String() string {
+	var parts []string
+	for i, p := range d {
+		switch {
+		case p == 1:
+			parts = append(parts, dimensionNames[i])
+		case p != 0:
+			parts = append(parts, fmt.Sprintf("%s^%d", dimensionNames[i], p))
+		}
+	}
+	if len(parts) == 0 {
+		return "dimensionless"
+	}
+	return strings.Join(parts, "*")
+}
+
+// Unit — единица измерения.
+type Unit struct {
+	Symbol      string   // обозначение в выражениях, например "km" или "mph"
+	Names       []string // полные имена, например "mile", "miles"
+	Quantity    string   // величина: length, speed, energy...
+	Factor      float64  // сколько единиц СИ в одной единице (1 mi = 1609.344 m)
+	Offset      float64  // сдвиг шкалы: K = degC*Factor + Offset; ненулевой только у температур
+	Prefixable  bool     // допускает приставки СИ: km, ms, µs, kWh
+	Description string
+
+	dim dimension
+}
+
+// prefix — десятичная приставка СИ.
+type prefix struct {
+	symbol string
+	name   string
+	factor float64
+}
+
+var prefixes = []prefix{
+	{"Q", "quetta", 1e30}, {"R", "ronna", 1e27}, {"Y", "yotta", 1e24}, {"Z", "zetta", 1e21},
+	{"E", "exa", 1e18}, {"P", "peta", 1e15}, {"T", "tera", 1e12}, {"G", "giga", 1e9},
+	{"M", "mega", 1e6}, {"k", "kilo", 1e3}, {"h", "hecto", 1e2}, {"da", "deca", 1e1},
+	{"d", "deci", 1e-1}, {"c", "centi", 1e-2}, {"m", "milli", 1e-3}, {"µ", "micro", 1e-6},
+	{"u", "micro", 1e-6}, {"n", "nano", 1e-9}, {"p", "pico", 1e-12}, {"f", "femto", 1e-15},
+	{"a", "atto", 1e-18}, {"z", "zepto", 1e-21}, {"y", "yocto", 1e-24}, {"r", "ronto", 1e-27},
+	{"q", "quecto", 1e-30},
+}
+
+// dims — размерность из степеней длины, массы, времени и т. д.
+func dims(length, mass, time, current, temperature, amount, luminosity int) dimension {
+	return dimension{length, mass, time, current, temperature, amount, luminosity}
+}
+
+var (
+	dimNone        = dimension{}
+	dimLength      = dims(1, 0, 0, 0, 0, 0, 0)
+	dimArea        = dims(2, 0, 0, 0, 0, 0, 0)
+	dimVolume      = dims(3, 0, 0, 0, 0, 0, 0)
+	dimMass        = dims(0, 1, 0, 0, 0, 0, 0)
+	dimTime        = dims(0, 0, 1, 0, 0, 0, 0)
+	dimTemperature = dims(0, 0, 0, 0, 1, 0, 0)
+	dimSpeed       = dims(1, 0, -1, 0, 0, 0, 0)
+	dimForce       = dims(1, 1, -2, 0, 0, 0, 0)
+	dimPressure    = dims(-1, 1, -2, 0, 0, 0, 0)
+	dimEnergy      = dims(2, 1, -2, 0, 0, 0, 0)
+	dimPower       = dims(2, 1, -3, 0, 0, 0, 0)
+	dimCurrency    = dimension{baseCount - 1: 1}
+)
+
+// unitTable — известные единицы. Порядок не важен: поиск идёт по unitIndex.
+var unitTable = []*Unit{
+	// Основные единицы СИ.
+	{Symbol: "m", Names: []string{"meter", "meters", "metre", "metres"}, Quantity: "length", Factor: 1, Prefixable: true, Description: "Metre", dim: dimLength},
+	{Symbol: "g", Names: []string{"gram", "grams"}, Quantity: "mass", Factor: 1e-3, Prefixable: true, Description: "Gram", dim: dimMass},
+	{Symbol: "s", Names: []string{"second", "seconds", "sec"}, Quantity: "time", Factor: 1, Prefixable: true, Description: "Second", dim: dimTime},
+	{Symbol: "A", Names: []string{"ampere", "amperes", "amp", "amps"}, Quantity: "current", Factor: 1, Prefixable: true, Description: "Ampere", dim: dims(0, 0, 0, 1, 0, 0, 0)},
+	{Symbol: "K", Names: []string{"kelvin"}, Quantity: "temperature", Factor: 1, Prefixable: true, Description: "Kelvin", dim: dimTemperature},
+	{Symbol: "mol", Names: []string{"mole", "moles"}, Quantity: "amount", Factor: 1, Prefixable: true, Description: "Mole", dim: dims(0, 0, 0, 0, 0, 1, 0)},
+	{Symbol: "cd", Names: []string{"candela"}, Quantity: "luminosity", Factor: 1, Prefixable: true, Description: "Candela", dim: dims(0, 0, 0, 0, 0, 0, 1)},
+
+	// Производные единицы СИ.
+	{Symbol: "Hz", Names: []string{"hertz"}, Quantity: "frequency", Factor: 1, Prefixable: true, Description: "Hertz, 1/s", dim: dims(0, 0, -1, 0, 0, 0, 0)},
+	{Symbol: "N", Names: []string{"newton", "newtons"}, Quantity: "force", Factor: 1, Prefixable: true, Description: "Newton, kg*m/s^2", dim: dimForce},
+	{Symbol: "Pa", Names: []string{"pascal", "pascals"}, Quantity: "pressure", Factor: 1, Prefixable: true, Description: "Pascal, N/m^2", dim: dimPressure},
+	{Symbol: "J", Names: []string{"joule", "joules"}, Quantity: "energy", Factor: 1, Prefixable: true, Description: "Joule, N*m", dim: dimEnergy},
+	{Symbol: "W", Names: []string{"watt", "watts"}, Quantity: "power", Factor: 1, Prefixable: true, Description: "Watt, J/s", dim: dimPower},
+	{Symbol: "C", Names: []string{"coulomb", "coulombs"}, Quantity: "charge", Factor: 1, Prefixable: true, Description: "Coulomb, A*s", dim: dims(0, 0, 1, 1, 0, 0, 0)},
+	{Symbol: "V", Names: []string{"volt", "volts"}, Quantity: "voltage", Factor: 1, Prefixable: true, Description: "Volt, W/A", dim: dims(2, 1, -3, -1, 0, 0, 0)},
+	{Symbol: "Ω", Names: []string{"ohm", "ohms"}, Quantity: "resistance", Factor: 1, Prefixable: true, Description: "Ohm, V/A", dim: dims(2, 1, -3, -2, 0, 0, 0)},
+	{Symbol: "rad", Names: []string{"radian", "radians"}, Quantity: "angle", Factor: 1, Description: "Radian", dim: dimNone},
+
+	// Единицы, принятые наравне с СИ.
+	{Symbol: "min", Names: []string{"minute", "minutes"}, Quantity: "time", Factor: 60, Description: "Minute", dim: dimTime},
+	{Symbol: "h", Names: []string{"hour", "hours", "hr"}, Quantity: "time", Factor: 3600, Description: "Hour", dim: dimTime},
+	{Symbol: "d", Names: []string{"day", "days"}, Quantity: "time", Factor: 86400, Description: "Day", dim: dimTime},
+	{Symbol: "wk", Names: []string{"week", "weeks"}, Quantity: "time", Factor: 604800, Description: "Week", dim: dimTime},
+	{Symbol: "yr", Names: []string{"year", "years"}, Quantity: "time", Factor: 31557600, Description: "Julian year, 365.25 days", dim: dimTime},
+	{Symbol: "L", Names: []string{"liter", "liters", "litre", "litres"}, Quantity: "volume", Factor: 1e-3, Prefixable: true, Description: "Litre, dm^3", dim: dimVolume},
+	{Symbol: "t", Names: []string{"tonne", "tonnes"}, Quantity: "mass", Factor: 1e3, Description: "Metric ton", dim: dimMass},
+	{Symbol: "ha", Names: []string{"hectare", "hectares"}, Quantity: "area", Factor: 1e4, Description: "Hectare", dim: dimArea},
+	{Symbol: "bar", Names: []string{"bars"}, Quantity: "pressure", Factor: 1e5, Prefixable: true, Description: "Bar", dim: dimPressure},
+	{Symbol: "atm", Names: []string{"atmosphere", "atmospheres"}, Quantity: "pressure", Factor: 101325, Description: "Standard atmosphere", dim: dimPressure},
+	{Symbol: "Wh", Names: []string{"watthour", "watthours"}, Quantity: "energy", Factor: 3600, Prefixable: true, Description: "Watt-hour", dim: dimEnergy},
+	{Symbol: "eV", Names: []string{"electronvolt", "electronvolts"}, Quantity: "energy", Factor: 1.602176634e-19, Prefixable: true, Description: "Electronvolt", dim: dimEnergy},
+	{Symbol: "cal", Names: []string{"calorie", "calories"}, Quantity: "energy", Factor: 4.184, Prefixable: true, Description: "Thermochemical calorie", dim: dimEnergy},
+	{Symbol: "kph", Names: []string{"kmh"}, Quantity: "speed", Factor: 1000.0 / 3600, Description: "Kilometre per hour", dim: dimSpeed},
+	{Symbol: "deg", Names: []string{"degree", "degrees"}, Quantity: "angle", Factor: math.Pi / 180, Description: "Degree of arc", dim: dimNone},
+	{Symbol: "degC", Names: []string{"celsius"}, Quantity: "temperature", Factor: 1, Offset: 273.15, Description: "Degree Celsius", dim: dimTemperature},
+	{Symbol: "degF", Names: []string{"fahrenheit"}, Quantity: "temperature", Factor: 5.0 / 9, Offset: 273.15 - 32*5.0/9, Description: "Degree Fahrenheit", dim: dimTemperature},
+
+	// Британские и американские единицы.
+	{Symbol: "in", Names: []string{"inch", "inches"}, Quantity: "length", Factor: 0.0254, Description: "Inch", dim: dimLength},
+	{Symbol: "ft", Names: []string{"foot", "feet"}, Quantity: "length", Factor: 0.3048, Description: "Foot", dim: dimLength},
+	{Symbol: "yd", Names: []string{"yard", "yards"}, Quantity: "length", Factor: 0.9144, Description: "Yard", dim: dimLength},
+	{Symbol: "mi", Names: []string{"mile", "miles"}, Quantity: "length", Factor: 1609.344, Description: "International mile", dim: dimLength},
+	{Symbol: "nmi", Names: []string{"nauticalmile", "nauticalmiles"}, Quantity: "length", Factor: 1852, Description: "Nautical mile", dim: dimLength},
+	{Symbol: "acre", Names: []string{"acres"}, Quantity: "area", Factor: 4046.8564224, Description: "International acre", dim: dimArea},
+	{Symbol: "gal", Names: []string{"gallon", "gallons"}, Quantity: "volume", Factor: 3.785411784e-3, Description: "US liquid gallon", dim: dimVolume},
+	{Symbol: "qt", Names: []string{"quart", "quarts"}, Quantity: "volume", Factor: 9.46352946e-4, Description: "US liquid quart", dim: dimVolume},
+	{Symbol: "pt", Names: []string{"pint", "pints"}, Quantity: "volume", Factor: 4.73176473e-4, Description: "US liquid pint", dim: dimVolume},
+	{Symbol: "floz", Names: []string{"fluidounce", "fluidounces"}, Quantity: "volume", Factor: 2.95735295625e-5, Description: "US fluid ounce", dim: dimVolume},
+	{Symbol: "oz", Names: []string{"ounce", "ounces"}, Quantity: "mass", Factor: 0.028349523125, Description: "Avoirdupois ounce", dim: dimMass},
+	{Symbol: "lb", Names: []string{"pound", "pounds", "lbs"}, Quantity: "mass", Factor: 0.45359237, Description: "Avoirdupois pound", dim: dimMass},
+	{Symbol: "st", Names: []string{"stone", "stones"}, Quantity: "mass", Factor: 6.35029318, Description: "Stone, 14 lb", dim: dimMass},
+	{Symbol: "mph", Names: nil, Quantity: "speed", Factor: 0.44704, Description: "Mile per hour", dim: dimSpeed},
+	{Symbol: "kn", Names: []string{"knot", "knots"}, Quantity: "speed", Factor: 1852.0 / 3600, Description: "Knot, nautical mile per hour", dim: dimSpeed},
+	{Symbol: "lbf", Names: []string{"poundforce"}, Quantity: "force", Factor: 4.4482216152605, Description: "Pound-force", dim: dimForce},
+	{Symbol: "psi", Names: nil, Quantity: "pressure", Factor: 6894.757293168361, Description: "Pound-force per square inch", dim: dimPressure},
+	{Symbol: "hp", Names: []string{"horsepower"}, Quantity: "power", Factor: 745.69987158227022, Description: "Mechanical horsepower", dim: dimPower},
+	{Symbol: "BTU", Names: []string{"btu"}, Quantity: "energy", Factor: 1055.05585262, Description: "International British thermal unit", dim: dimEnergy},
+}
+
+// unitIndex — единицы по обозначению и полным именам.
+var unitIndex = map[string]*Unit{}
+
+func init() {
+	for _, u := range unitTable {
+		for _, name := range append([]string{u.Symbol}, u.Names...) {
+			if _, dup := unitIndex[name]; dup {
+				panic("duplicate unit name " + name)
+			}
+			unitIndex[name] = u
+		}
+	}
+}
+
+// currencyUnit — валюта как единица измерения. rate — сколько единиц валюты
+// дают за одну базовую (см. Env.Rates), поэтому в базовой валюте она стоит 1/rate.
+func currencyUnit(code string, rate float64) *Unit {
+	return &Unit{Symbol: code, Quantity: "currency", Factor: 1 / rate, Description: "Currency " + code, dim: dimCurrency}
+}
+
+// unitFactor — единица с приставкой: "km" — метр с множителем 1000.
+type unitFactor struct {
+	symbol string // как записано в выражении
+	unit   *Unit
+	scale  float64 // единиц СИ в одной такой единице
+	power  int
+}
+
+// lookupUnit — единица по имени: обозначение или полное имя, возможно
+// с приставкой ("km", "kilometre", "µs"). Имена без приставки важнее:
+// "min" — минута, а не милли-дюйм.
+func lookupUnit(name string) (unitFactor, bool) {
+	if u, ok := unitIndex[name]; ok {
+		return unitFactor{symbol: name, unit: u, scale: u.Factor, power: 1}, true
+	}
+	for _, p := range prefixes {
+		// Приставка-обозначение — к обозначению (km), полная — к имени (kilometre).
+		if rest, ok := strings.CutPrefix(name, p.symbol); ok {
+			if u, ok := unitIndex[rest]; ok && u.Prefixable && rest == u.Symbol {
+				return unitFactor{symbol: name, unit: u, scale: p.factor * u.Factor, power: 1}, true
+			}
+		}
+		if rest, ok := strings.CutPrefix(name, p.name); ok {
+			if u, ok := unitIndex[rest]; ok && u.Prefixable && rest != u.Symbol {
+				return unitFactor{symbol: name, unit: u, scale: p.factor * u.Factor, power: 1}, true
+			}
+		}
+	}
+	return unitFactor{}, false
+}
+
+// unitExpr — произведение единиц в степенях в порядке появления: km/h — это
+// {km^1, h^-1}. Пустое — безразмерная величина.
+type unitExpr []unitFactor
+
+func (u unitExpr) dim() dimension {
+	var d dimension
+	for _, f := range u {
+		d = d.add(f.unit.dim, f.power)
+	}
+	return d
+}
+
+// scale — сколько единиц СИ в одной единице u.
+func (u unitExpr) scale() float64 {
+	s := 1.0
+	for _, f := range u {
+		s *= math.Pow(f.scale, float64(f.power))
+	}
+	return s
+}
+
+// offset — сдвиг шкалы; учитывается, только если единица — одна температура
+// со сдвигом (degC, degF). В составных единицах (degC/min) это разность температур.
+func (u unitExpr) offset() float64 {
+	if len(u) == 1 && u[0].power == 1 {
+		return u[0].unit.Offset
+	}
+	return 0
+}
+
+// String — запись единицы: "km/h", "kg*m^2/s^2", "1/s".
+func (u unitExpr) String() string {
+	var num, den []string
+	for _, f := range u {
+		p := f.power
+		part := &num
+		if p < 0 {
+			part, p = &den, -p
+		}
+		if p == 1 {
+			*part = append(*part, f.symbol)
+		} else {
+			*part = append(*part, f.symbol+"^"+strconv.Itoa(p))
+		}
+	}
+	s := strings.Join(num, "*")
+	switch {
+	case len(den) == 0:
+		return s
+	case s == "":
+		s = "1"
+	}
+	if len(den) == 1 {
+		return s + "/" + den[0]
+	}
+	return s + "/(" + strings.Join(den, "*") + ")"
+}
+
+// quantity — значение в единицах unit: 5 km — {5, km}.
+type quantity struct {
+	value float64
+	unit  unitExpr
+}
+
+// si — значение в единицах СИ.
+func (q quantity) si() float64 {
+	return q.value*q.unit.scale() + q.unit.offset()
+}
+
+// in — значение q в единицах unit той же размерности. Температуры
+// переводятся по шкале (0 degC = 32 degF), если absolute, и как разности
+// (1 degC = 1.8 degF) — иначе: при сложении и вычитании.
+func (q quantity) in(unit unitExpr, absolute bool) (float64, error) {
+	if q.unit.dim() != unit.dim() {
+		return 0, fmt.Errorf("%w: cannot convert %s to %s", ErrDimensionMismatch, q.describe(), describeUnit(unit))
+	}
+	if !absolute {
+		return q.value * q.unit.scale() / unit.scale(), nil
+	}
+	return (q.si() - unit.offset()) / unit.scale(), nil
+}
+
+// describe — единица и размерность для сообщений об ошибках.
+func (q quantity) describe() string {
+	return describeUnit(q.unit)
+}
+
+func describeUnit(u unitExpr) string {
+	if len(u) == 0 {
+		return "a dimensionless number"
+	}
+	return fmt.Sprintf("%s (%s)", u, u.dim())
+}
+
+// mul — произведение (k = 1) или частное (k = -1) величин. Единицы одной
+// размерности сводятся к первой встреченной: 2 m * 30 cm = 0.6 m^2.
+func (q quantity) mul(o quantity, k int) quantity {
+	value := q.value
+	unit := append(unitExpr(nil), q.unit...)
+	for _, f := range o.unit {
+		f.power *= k
+		i := unit.find(f)
+		if i < 0 {
+			unit = append(unit, f)
+			continue
+		}
+		value *= math.Pow(f.scale/unit[i].scale, float64(f.power))
+		unit[i].power += f.power
+	}
+	if k < 0 {
+		value /= o.value
+	} else {
+		value *= o.value
+	}
+	kept := unit[:0]
+	for _, f := range unit {
+		if f.power != 0 {
+			kept = append(kept, f)
+		}
+	}
+	return quantity{value: value, unit: kept}
+}
+
+// find — индекс множителя с тем же обозначением или той же базовой
+// единицей размерности f; -1 — такого нет.
+func (u unitExpr) find(f unitFactor) int {
+	for i, g := range u {
+		if g.symbol == f.symbol {
+			return i
+		}
+	}
+	for i, g := range u {
+		if g.unit.dim == f.unit.dim && g.unit.Offset == 0 && f.unit.Offset == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// pow — возведение в степень; у размерной величины показатель должен
+// давать целые степени единиц: (m^2)^0.5 = m, но m^0.5 — ошибка.
+func (q quantity) pow(n float64) (quantity, error) {
+	unit := make(unitExpr, len(q.unit))
+	for i, f := range q.unit {
+		p := float64(f.power) * n
+		if p != math.Trunc(p) {
+			return quantity{}, fmt.Errorf("%w: %s raised to the power %v", ErrDimensionMismatch, q.describe(), n)
+		}
+		f.power = int(p)
+		unit[i] = f
+	}
+	if n == 0 {
+		unit = nil
+	}
+	return quantity{value: math.Pow(q.value, n), unit: unit}, nil
+}
+
+// number — безразмерное значение величины (углы — в радианах).
+func (q quantity) number(what string) (float64, error) {
+	if q.unit.dim() != dimNone {
+		return 0, fmt.Errorf("%w: %s needs a dimensionless value, got %s", ErrDimensionMismatch, what, q.describe())
+	}
+	return q.si(), nil
+}
@@ -0,0 +1,350 @@
+package calculationService
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// UnitsEngine — имя движка величин с единицами измерения.
+const UnitsEngine = "units"
+
+// unitsEvaluator — движок величин с единицами (float64): "5 km / 20 min to km/h".
+// Число и имя единицы после него перемножаются; величины одной размерности
+// складываются с переводом во вторую единицу первой, разных — ErrDimensionMismatch.
+// Результат записывается в единицах выражения или указанных после "to"
+// (или "in"). Валюты — тоже единицы: их курсы берутся из Env.Rates.
+type unitsEvaluator struct{}
+
+// unitNode — имя единицы измерения в выражении (после разбора в Parse).
+type unitNode struct {
+	unit unitFactor
+	pos  int
+}
+
+func (n *unitNode) offset() int { return n.pos }
+
+// NewUnitsEvaluator — создаёт движок величин с единицами измерения.
+func NewUnitsEvaluator() Evaluator {
+	return unitsEvaluator{}
+}
+
+func (unitsEvaluator) Name() string { return UnitsEngine }
+
+func (unitsEvaluator) Capabilities() Capabilities {
+	return Capabilities{
+		Description: "Physical quantities and money (float64): SI and imperial units, SI prefixes, currencies at stored exchange rates, dimension checking and conversion with \"to\" or \"in\"",
+		Modes:       []string{ModeUnits},
+		Variables:   true,
+		Functions:   true,
+	}
+}
+
+// Parse — разбирает выражение и находит в нём единицы и валюты. Переменные
+// и константы важнее единиц: если у пользователя есть переменная m, то m —
+// переменная, а не метр; единицы важнее валют. После "to" допустимы только
+// единицы и валюты.
+func (unitsEvaluator) Parse(expression string, env Env) (Program, error) {
+	root, err := parseUnitsExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if err := env.Limits.checkTree(expression, root); err != nil {
+		return nil, err
+	}
+	root, err = resolveUnits(root, env.Variables, env.Rates)
+	if err != nil {
+		return nil, err
+	}
+	return &astProgram{source: expression, root: root}, nil
+}
+
+// resolveUnits — заменяет имена единиц и валют в дереве на unitNode.
+func resolveUnits(n node, vars, rates map[string]string) (node, error) {
+	var err error
+	switch n := n.(type) {
+	case *identNode:
+		if _, ok := vars[n.name]; ok {
+			return n, nil
+		}
+		if u, ok := lookupUnit(n.name); ok {
+			return &unitNode{unit: u, pos: n.pos}, nil
+		}
+		if rate, ok := rates[n.name]; ok {
+			x, err := strconv.ParseFloat(rate, 64)
+			if err != nil || x <= 0 {
+				return nil, fmt.Errorf("currency %q has malformed rate %q", n.name, rate)
+			}
+			u := currencyUnit(n.name, x)
+			return &unitNode{unit: unitFactor{symbol: n.name, unit: u, scale: u.Factor, power: 1}, pos: n.pos}, nil
+		}
+	case *unaryNode:
+		n.x, err = resolveUnits(n.x, vars, rates)
+	case *binaryNode:
+		if n.x, err = resolveUnits(n.x, vars, rates); err == nil {
+			n.y, err = resolveUnits(n.y, vars, rates)
+		}
+	case *callNode:
+		for i := range n.args {
+			if n.args[i], err = resolveUnits(n.args[i], vars, rates); err != nil {
+				break
+			}
+		}
+	case *convertNode:
+		if n.x, err = resolveUnits(n.x, vars, rates); err == nil {
+			n.unit, err = resolveUnits(n.unit, nil, rates)
+		}
+		if err == nil {
+			if name := identifiers(n.unit); len(name) > 0 {
+				err = &NameError{Err: ErrUnknownUnit, Name: name[0], Offset: nameNode(n.unit, name[0]).offset()}
+			}
+		}
+	}
+	return n, err
+}
+
+// nameNode — первый узел-имя name в дереве.
+func nameNode(root node, name string) node {
+	var found node
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case *identNode:
+			if found == nil && n.name == name {
+				found = n
+			}
+		case *unaryNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.x)
+			walk(n.y)
+		}
+	}
+	walk(root)
+	return found
+}
+
+func (unitsEvaluator) Evaluate(ctx context.Context, program Program, env Env) (Evaluation, error) {
+	p, ok := program.(*astProgram)
+	if !ok {
+		return Evaluation{}, fmt.Errorf("units: foreign program %T", program)
+	}
+	env.ctx = ctx
+
+	q, err := evalQuantity(p.root, env)
+	if err != nil {
+		return Evaluation{}, err
+	}
+	return Evaluation{Result: formatQuantity(q.value), Unit: q.unit.String(), Rates: usedRates(p.root, env.Rates)}, nil
+}
+
+// usedRates — курсы валют, встречающихся в дереве; nil — валют нет.
+func usedRates(root node, rates map[string]string) Bindings {
+	var used Bindings
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case *unitNode:
+			if n.unit.unit.dim == dimCurrency {
+				if used == nil {
+					used = Bindings{}
+				}
+				used[n.unit.symbol] = rates[n.unit.symbol]
+			}
+		case *unaryNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.x)
+			walk(n.y)
+		case *callNode:
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		case *convertNode:
+			walk(n.x)
+			walk(n.unit)
+		}
+	}
+	walk(root)
+	return used
+}
+
+// formatQuantity — значение с 15 значащими цифрами: перевод единиц
+// оставляет погрешность в последних знаках (15.000000000000002 km/h).
+func formatQuantity(x float64) string {
+	if x == 0 {
+		return "0" // и для -0
+	}
+	return strconv.FormatFloat(x, 'g', 15, 64)
+}
+
+// evalQuantity — вычисляет дерево в величинах с единицами.
+func evalQuantity(n node, env Env) (quantity, error) {
+	if err := env.interrupted(); err != nil {
+		return quantity{}, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		x, err := strconv.ParseFloat(n.text, 64)
+		if err != nil {
+			return quantity{}, &SyntaxError{Offset: n.pos, Token: n.text, Message: "malformed number"}
+		}
+		return quantity{value: x}, nil
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			return quantity{}, unsupportedNode(n)
+		}
+		x, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return quantity{}, fmt.Errorf("variable %q has malformed value %q", n.name, value)
+		}
+		return quantity{value: x}, nil
+	case *unitNode:
+		return quantity{value: 1, unit: unitExpr{n.unit}}, nil
+	case *callNode:
+		f, ok := env.Functions[n.name]
+		if !ok {
+			return quantity{}, unsupportedNode(n)
+		}
+		args := make([]quantity, len(n.args))
+		for i, arg := range n.args {
+			x, err := evalQuantity(arg, env)
+			if err != nil {
+				return quantity{}, err
+			}
+			args[i] = x
+		}
+		return callQuantity(f, args, env)
+	case *unaryNode:
+		x, err := evalQuantity(n.x, env)
+		if err != nil {
+			return quantity{}, err
+		}
+		if n.op == "-" {
+			x.value = -x.value
+		}
+		return x, nil
+	case *binaryNode:
+		x, err := evalQuantity(n.x, env)
+		if err != nil {
+			return quantity{}, err
+		}
+		y, err := evalQuantity(n.y, env)
+		if err != nil {
+			return quantity{}, err
+		}
+		switch n.op {
+		case "+", "-", "%":
+			v, err := y.in(x.unit, false)
+			if err != nil {
+				return quantity{}, err
+			}
+			switch n.op {
+			case "+":
+				x.value += v
+			case "-":
+				x.value -= v
+			default:
+				if v == 0 {
+					return quantity{}, ErrDivisionByZero
+				}
+				x.value = math.Mod(x.value, v)
+			}
+			return x, nil
+		case "*":
+			return x.mul(y, 1), nil
+		case "/":
+			if y.value == 0 {
+				return quantity{}, ErrDivisionByZero
+			}
+			return x.mul(y, -1), nil
+		case "^":
+			k, err := y.number("exponent")
+			if err != nil {
+				return quantity{}, err
+			}
+			return x.pow(k)
+		}
+	case *convertNode:
+		x, err := evalQuantity(n.x, env)
+		if err != nil {
+			return quantity{}, err
+		}
+		target, err := evalQuantity(n.unit, env)
+		if err != nil {
+			return quantity{}, err
+		}
+		if target.value != 1 || len(target.unit) == 0 {
+			return quantity{}, &SyntaxError{Offset: n.unit.offset(), Message: "conversion target must be a unit, e.g. km/h"}
+		}
+		v, err := x.in(target.unit, true)
+		if err != nil {
+			return quantity{}, err
+		}
+		return quantity{value: v, unit: target.unit}, nil
+	}
+	return quantity{}, unsupportedNode(n)
+}
+
+// keepsUnit — встроенные функции, результат которых в единицах первого
+// аргумента: abs(-3 m) = 3 m, round(2.46 km, 1) = 2.5 km.
+var keepsUnit = map[string]bool{"abs": true, "floor": true, "ceil": true, "trunc": true, "round": true}
+
+// callQuantity — вызов функции от величин. Функции, сохраняющие единицы,
+// считаются в единицах первого аргумента (min и max переводят остальные
+// аргументы в них же), sqrt и cbrt извлекают корень и из единиц (m^2 → m).
+// Остальным функциям нужны безразмерные аргументы; углы в градусах
+// передаются им в радианах.
+func callQuantity(f *Function, args []quantity, env Env) (quantity, error) {
+	if err := f.checkArity(len(args)); err != nil {
+		return quantity{}, err
+	}
+	builtin := builtinFunctions[f.Name] == f
+	values := make([]float64, len(args))
+	var unit unitExpr
+	switch {
+	case builtin && (f.Name == "min" || f.Name == "max"):
+		unit = args[0].unit
+		for i, a := range args {
+			v, err := a.in(unit, true)
+			if err != nil {
+				return quantity{}, err
+			}
+			values[i] = v
+		}
+	case builtin && (f.Name == "sqrt" || f.Name == "cbrt"):
+		root := map[string]float64{"sqrt": 2, "cbrt": 3}[f.Name]
+		q, err := args[0].pow(1 / root)
+		if err != nil {
+			return quantity{}, err
+		}
+		unit, values[0] = q.unit, args[0].value
+	case builtin && keepsUnit[f.Name]:
+		unit, values[0] = args[0].unit, args[0].value
+		for i, a := range args[1:] {
+			v, err := a.number(f.Name + " argument")
+			if err != nil {
+				return quantity{}, err
+			}
+			values[i+1] = v
+		}
+	default:
+		for i, a := range args {
+			v, err := a.number(f.Name + " argument")
+			if err != nil {
+				return quantity{}, err
+			}
+			if len(a.unit) > 0 {
+				env.AngleUnit = AngleRadians // a.number вернул угол в радианах
+			}
+			values[i] = v
+		}
+	}
+	v, err := f.callFloat(env, values)
+	if err != nil {
+		return quantity{}, err
+	}
+	return quantity{value: v, unit: unit}, nil
+}
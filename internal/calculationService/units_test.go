@@ -0,0 +1,176 @@
+package calculationService
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnits(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		wantUnit   string
+		wantErr    error
+	}{
+		{name: "скорость в единицах выражения", expression: "5 km / 20 min", want: "0.25", wantUnit: "km/min"},
+		{name: "перевод в км/ч", expression: "5 km / 20 min to km/h", want: "15", wantUnit: "km/h"},
+		{name: "перевод в мили в час", expression: "100 km/h to mph", want: "62.1371192237334", wantUnit: "mph"},
+		{name: "сложение переводит во вторую единицу", expression: "1 m + 20 cm", want: "1.2", wantUnit: "m"},
+		{name: "одна размерность сводится к одной единице", expression: "2 m * 30 cm", want: "0.6", wantUnit: "m^2"},
+		{name: "единицы сокращаются", expression: "6 km / 3 m", want: "2000"},
+		{name: "полные имена и приставки", expression: "3 kilometres to m", want: "3000", wantUnit: "m"},
+		{name: "производная единица", expression: "2 kg * 3 m/s^2 to N", want: "6", wantUnit: "N"},
+		{name: "киловатт-час в джоулях", expression: "1 kWh to MJ", want: "3.6", wantUnit: "MJ"},
+		{name: "составная единица в знаменателе", expression: "1 kg/(m*s^2) to Pa", want: "1", wantUnit: "Pa"},
+		{name: "обратная единица", expression: "120 / min to 1/s", want: "2", wantUnit: "1/s"},
+		{name: "температура по шкале", expression: "100 degC to degF", want: "212", wantUnit: "degF"},
+		{name: "разность температур", expression: "20 degC + 5 K", want: "25", wantUnit: "degC"},
+		{name: "корень из площади", expression: "sqrt(16 m^2)", want: "4", wantUnit: "m"},
+		{name: "функция сохраняет единицу", expression: "round(2.46 km, 1) + abs(-500 m)", want: "3", wantUnit: "km"},
+		{name: "угол в градусах", expression: "sin(90 deg)", want: "1"},
+		{name: "число без единиц", expression: "2 + 2", want: "4"},
+		{name: "метры плюс секунды", expression: "1 m + 1 s", wantErr: ErrDimensionMismatch},
+		{name: "перевод в другую размерность", expression: "5 km to kg", wantErr: ErrDimensionMismatch},
+		{name: "дробная степень единицы", expression: "sqrt(2 m)", wantErr: ErrDimensionMismatch},
+		{name: "размерный аргумент функции", expression: "ln(3 m)", wantErr: ErrDimensionMismatch},
+		{name: "неизвестная единица перевода", expression: "5 km to parsec", wantErr: ErrUnknownUnit},
+		{name: "неизвестное имя", expression: "5 parsec", wantErr: ErrUnknownVariable},
+		{name: "деление на ноль", expression: "5 m / (0 s)", wantErr: ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service := NewCalculationService(mockRepo)
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", EvalOptions{Mode: ModeUnits})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, UnitsEngine, result.Engine)
+				assert.Equal(t, tt.want, result.Result)
+				assert.Equal(t, tt.wantUnit, result.Unit)
+			}
+		})
+	}
+}
+
+func TestUnitsVariablesShadowUnits(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil)
+
+	service := NewCalculationService(mockRepo, WithVariableSource(staticVariables{"m": "3"}))
+	result, err := service.CreateCalculation(t.Context(), "m * 2 s", "alice", EvalOptions{Mode: ModeUnits})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "6", result.Result)
+	assert.Equal(t, "s", result.Unit)
+	assert.Equal(t, Bindings{"m": "3"}, result.Variables)
+}
+
+func TestLookupUnit(t *testing.T) {
+	tests := []struct {
+		name   string
+		symbol string
+		scale  float64
+	}{
+		{"km", "m", 1000},
+		{"min", "min", 60}, // минута, а не милли-дюйм
+		{"µs", "s", 1e-6},
+		{"milligram", "g", 1e-6},
+		{"kWh", "Wh", 3.6e6},
+		{"Mmi", "", 0},   // у миль нет приставок
+		{"kilom", "", 0}, // полная приставка — только к полному имени
+	}
+	for _, tt := range tests {
+		f, ok := lookupUnit(tt.name)
+		if tt.symbol == "" {
+			assert.False(t, ok, tt.name)
+			continue
+		}
+		if assert.True(t, ok, tt.name) {
+			assert.Equal(t, tt.symbol, f.unit.Symbol, tt.name)
+			assert.InEpsilon(t, tt.scale, f.scale, 1e-12, tt.name)
+		}
+	}
+}
+
+// staticRates — курсы валют для тестов; запоминает, на какой момент их просили.
+type staticRates struct {
+	rates map[string]string
+	at    time.Time
+}
+
+func (r *staticRates) RatesAt(_ context.Context, at time.Time) (map[string]string, error) {
+	r.at = at
+	return r.rates, nil
+}
+
+func TestCurrency(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		wantUnit   string
+		wantRates  Bindings
+		wantErr    error
+	}{
+		{
+			name:       "сумма в разных валютах",
+			expression: "120 USD + 35 EUR in GBP",
+			want:       "124.854347826087",
+			wantUnit:   "GBP",
+			wantRates:  Bindings{"USD": "1", "EUR": "0.92", "GBP": "0.79"},
+		},
+		{name: "перевод через to", expression: "100 EUR to USD", want: "108.695652173913", wantUnit: "USD", wantRates: Bindings{"EUR": "0.92", "USD": "1"}},
+		{name: "деление суммы", expression: "10 GBP / 4", want: "2.5", wantUnit: "GBP", wantRates: Bindings{"GBP": "0.79"}},
+		{name: "in перед знаком — дюймы", expression: "3 in + 1 ft", want: "15", wantUnit: "in"},
+		{name: "деньги плюс метры", expression: "5 USD + 1 m", wantErr: ErrDimensionMismatch},
+		{name: "нет курса валюты перевода", expression: "100 USD in JPY", wantErr: ErrUnknownUnit},
+		{name: "нет курса валюты", expression: "100 JPY", wantErr: ErrUnknownVariable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTaskRepository)
+			mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			rates := &staticRates{rates: map[string]string{"USD": "1", "EUR": "0.92", "GBP": "0.79"}}
+			service := NewCalculationService(mockRepo, WithRateSource(rates))
+			result, err := service.CreateCalculation(t.Context(), tt.expression, "", EvalOptions{Mode: ModeUnits})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, result.Result)
+				assert.Equal(t, tt.wantUnit, result.Unit)
+				assert.Equal(t, tt.wantRates, result.Rates)
+				assert.Equal(t, result.UpdatedAt, rates.at, "курсы на момент вычисления")
+			}
+		})
+	}
+}
+
+func TestCurrencyRatesOnlyInUnitsMode(t *testing.T) {
+	mockRepo := new(MockTaskRepository)
+	mockRepo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil)
+
+	rates := &staticRates{rates: map[string]string{"USD": "1"}}
+	service := NewCalculationService(mockRepo, WithRateSource(rates))
+	result, err := service.CreateCalculation(t.Context(), "2 + 2", "", EvalOptions{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Rates)
+	assert.True(t, rates.at.IsZero(), "курсы не нужны вне режима units")
+}
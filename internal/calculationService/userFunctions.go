@@ -0,0 +1,363 @@
+package calculationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// CategoryUser — категория функций, определённых пользователем.
+const CategoryUser = "user"
+
+var (
+	// ErrRecursion — функция прямо или через другие функции вызывает саму себя.
+	ErrRecursion = errors.New("recursive function definition")
+	// ErrInvalidDefinition — некорректные имя, параметры или тело функции.
+	ErrInvalidDefinition = errors.New("invalid function definition")
+)
+
+// FunctionDefinition — функция пользователя: f(x, y) = x^2 + y.
+// Тело записывается в синтаксисе собственного разборщика (+ - * / % ^,
+// вызовы функций, переменные и константы) и одинаково работает во всех режимах.
+type FunctionDefinition struct {
+	Name        string
+	Params      []string
+	Body        string
+	Description string
+}
+
+// String — запись определения в виде "f(x, y) = x^2 + y".
+func (d FunctionDefinition) String() string {
+	return fmt.Sprintf("%s(%s) = %s", d.Name, strings.Join(d.Params, ", "), d.Body)
+}
+
+// FunctionSource — откуда сервис берёт функции пользователя.
+type FunctionSource interface {
+	FunctionsForUser(ctx context.Context, userID string) ([]FunctionDefinition, error)
+}
+
+// WithFunctionSource — подключает хранилище функций пользователей.
+// Без него в выражениях доступны только встроенные функции.
+func WithFunctionSource(src FunctionSource) Option {
+	return func(s *calcService) {
+		s.functions = src
+	}
+}
+
+// ValidateFunctions — проверяет набор функций одного пользователя целиком:
+// имена и параметры, синтаксис тел, существование и арность вызываемых
+// функций и отсутствие рекурсии (в том числе взаимной).
+func ValidateFunctions(defs []FunctionDefinition) error {
+	_, err := parseDefinitions(defs)
+	return err
+}
+
+// parseDefinitions — разбирает тела функций и проверяет набор; возвращает деревья по имени.
+func parseDefinitions(defs []FunctionDefinition) (map[string]node, error) {
+	byName := make(map[string]FunctionDefinition, len(defs))
+	bodies := make(map[string]node, len(defs))
+	for _, d := range defs {
+		if err := validateSignature(d); err != nil {
+			return nil, err
+		}
+		if _, dup := byName[d.Name]; dup {
+			return nil, fmt.Errorf("%w: %s is defined twice", ErrInvalidDefinition, d.Name)
+		}
+		body, err := parseExpression(d.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Name, err)
+		}
+		byName[d.Name] = d
+		bodies[d.Name] = body
+	}
+
+	for _, d := range defs {
+		for _, call := range calls(bodies[d.Name]) {
+			if f, ok := builtinFunctions[call.name]; ok {
+				if err := f.checkArity(len(call.args)); err != nil {
+					return nil, fmt.Errorf("%s: %w", d.Name, err)
+				}
+				continue
+			}
+			callee, ok := byName[call.name]
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", d.Name, unsupportedNode(call))
+			}
+			if len(call.args) != len(callee.Params) {
+				return nil, fmt.Errorf("%s: %w: %s takes %d, got %d", d.Name, ErrArity, callee.Name, len(callee.Params), len(call.args))
+			}
+		}
+	}
+
+	if err := checkRecursion(bodies); err != nil {
+		return nil, err
+	}
+	return bodies, nil
+}
+
+// validateSignature — имя и параметры должны быть идентификаторами;
+// имя не должно совпадать со встроенной функцией или константой.
+func validateSignature(d FunctionDefinition) error {
+	if !isIdentifier(d.Name) {
+		return fmt.Errorf("%w: name %q is not an identifier", ErrInvalidDefinition, d.Name)
+	}
+	if IsBuiltinFunction(d.Name) || IsConstant(d.Name) {
+		return fmt.Errorf("%w: name %q is reserved", ErrInvalidDefinition, d.Name)
+	}
+	seen := make(map[string]bool, len(d.Params))
+	for _, p := range d.Params {
+		if !isIdentifier(p) || IsConstant(p) {
+			return fmt.Errorf("%w: %s: parameter %q", ErrInvalidDefinition, d.Name, p)
+		}
+		if seen[p] {
+			return fmt.Errorf("%w: %s: duplicate parameter %q", ErrInvalidDefinition, d.Name, p)
+		}
+		seen[p] = true
+	}
+	return nil
+}
+
+// isIdentifier — строка целиком является одним токеном-идентификатором.
+func isIdentifier(s string) bool {
+	tokens, err := lex(s)
+	return err == nil && len(tokens) == 2 && tokens[0].kind == tokIdent && tokens[0].text == s
+}
+
+// checkRecursion — ищет цикл в графе вызовов функций пользователя.
+func checkRecursion(bodies map[string]node) error {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, len(bodies))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case inProgress:
+			return fmt.Errorf("%w: %s", ErrRecursion, strings.Join(append(path, name), " -> "))
+		case done:
+			return nil
+		}
+		state[name] = inProgress
+		for _, call := range calls(bodies[name]) {
+			if _, user := bodies[call.name]; user {
+				if err := visit(call.name, append(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+		state[name] = done
+		return nil
+	}
+	for name := range bodies {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// userFunctions — функции пользователя, скомпилированные для одного вычисления.
+// used запоминает, какие из них действительно вызывались, чтобы сохранить
+// их определения вместе с результатом.
+type userFunctions struct {
+	defs   map[string]FunctionDefinition
+	bodies map[string]node
+	used   map[string]bool
+}
+
+// compileFunctions — добавляет к встроенным функциям функции пользователя.
+// env — окружение вычисления; его Functions указывает на возвращаемую карту,
+// поэтому функции пользователя могут вызывать друг друга.
+func compileFunctions(defs []FunctionDefinition, env *Env) (*userFunctions, error) {
+	funcs := make(map[string]*Function, len(builtinFunctions)+len(defs))
+	for name, f := range builtinFunctions {
+		funcs[name] = f
+	}
+	env.Functions = funcs
+
+	compiled := &userFunctions{defs: map[string]FunctionDefinition{}, bodies: map[string]node{}, used: map[string]bool{}}
+	if len(defs) == 0 {
+		return compiled, nil
+	}
+
+	bodies, err := parseDefinitions(defs)
+	if err != nil {
+		return nil, err
+	}
+	compiled.bodies = bodies
+	for _, d := range defs {
+		compiled.defs[d.Name] = d
+		funcs[d.Name] = compiled.function(d, bodies[d.Name], env)
+	}
+	return compiled, nil
+}
+
+// function — Function, вычисляющая тело d с аргументами, подставленными
+// вместо параметров. Тело видит те же переменные и функции, что и выражение.
+func (u *userFunctions) function(d FunctionDefinition, body node, env *Env) *Function {
+	local := func(args []string) Env {
+		e := *env
+		e.Variables = make(map[string]string, len(env.Variables)+len(args))
+		for name, value := range env.Variables {
+			e.Variables[name] = value
+		}
+		for i, p := range d.Params {
+			e.Variables[p] = args[i]
+		}
+		return e
+	}
+
+	return &Function{
+		Name:        d.Name,
+		Category:    CategoryUser,
+		MinArgs:     len(d.Params),
+		MaxArgs:     len(d.Params),
+		Description: d.Description,
+		float: func(_ Env, args []float64) (float64, error) {
+			u.used[d.Name] = true
+			values := make([]string, len(args))
+			for i, a := range args {
+				values[i] = strconv.FormatFloat(a, 'g', -1, 64)
+			}
+			return evalFloat(body, local(values))
+		},
+		exact: func(args []*big.Rat) (*big.Rat, error) {
+			u.used[d.Name] = true
+			values := make([]string, len(args))
+			for i, a := range args {
+				values[i] = a.RatString()
+			}
+			return evalRat(body, local(values))
+		},
+		decimal: func(prec uint, args []*big.Float) (*big.Float, error) {
+			u.used[d.Name] = true
+			values := make([]string, len(args))
+			for i, a := range args {
+				values[i] = a.Text('g', -1)
+			}
+			return evalDecimal(body, prec, local(values))
+		},
+		complex: func(_ Env, args []complex128) (complex128, error) {
+			u.used[d.Name] = true
+			values := make([]string, len(args))
+			for i, a := range args {
+				values[i] = strconv.FormatComplex(a, 'g', -1, 128)
+			}
+			return evalComplex(body, local(values))
+		},
+	}
+}
+
+// bindings — определения вызванных функций пользователя.
+func (u *userFunctions) bindings() Bindings {
+	if len(u.used) == 0 {
+		return nil
+	}
+	b := make(Bindings, len(u.used))
+	for name := range u.used {
+		b[name] = u.defs[name].String()
+	}
+	return b
+}
+
+// bindVariables — добавляет в used значения переменных, которые читали
+// тела вызванных функций (кроме их собственных параметров).
+func (u *userFunctions) bindVariables(used Bindings, vars map[string]string) Bindings {
+	for name := range u.used {
+		params := make(map[string]bool, len(u.defs[name].Params))
+		for _, p := range u.defs[name].Params {
+			params[p] = true
+		}
+		for _, ident := range identifiers(u.bodies[name]) {
+			value, ok := vars[ident]
+			if params[ident] || !ok {
+				continue
+			}
+			if used == nil {
+				used = Bindings{}
+			}
+			used[ident] = value
+		}
+	}
+	return used
+}
+
+// evalFloat — вычисляет дерево в float64 (тела функций пользователя в режиме float).
+func evalFloat(n node, env Env) (float64, error) {
+	if err := env.interrupted(); err != nil {
+		return 0, err
+	}
+	switch n := n.(type) {
+	case *numberNode:
+		x, err := strconv.ParseFloat(n.text, 64)
+		if err != nil {
+			return 0, &SyntaxError{Offset: n.pos, Token: n.text, Message: "malformed number"}
+		}
+		return x, nil
+	case *identNode:
+		value, ok := env.Variables[n.name]
+		if !ok {
+			return 0, unsupportedNode(n)
+		}
+		return strconv.ParseFloat(value, 64)
+	case *callNode:
+		f, ok := env.Functions[n.name]
+		if !ok {
+			return 0, unsupportedNode(n)
+		}
+		args := make([]float64, len(n.args))
+		for i, arg := range n.args {
+			x, err := evalFloat(arg, env)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = x
+		}
+		return f.callFloat(env, args)
+	case *unaryNode:
+		x, err := evalFloat(n.x, env)
+		if err != nil {
+			return 0, err
+		}
+		if n.op == "-" {
+			return -x, nil
+		}
+		return x, nil
+	case *binaryNode:
+		x, err := evalFloat(n.x, env)
+		if err != nil {
+			return 0, err
+		}
+		y, err := evalFloat(n.y, env)
+		if err != nil {
+			return 0, err
+		}
+		switch n.op {
+		case "+":
+			return x + y, nil
+		case "-":
+			return x - y, nil
+		case "*":
+			return x * y, nil
+		case "/":
+			if y == 0 {
+				return 0, ErrDivisionByZero
+			}
+			return x / y, nil
+		case "%":
+			if y == 0 {
+				return 0, ErrDivisionByZero
+			}
+			return math.Mod(x, y), nil
+		case "^":
+			return math.Pow(x, y), nil
+		}
+	}
+	return 0, unsupportedNode(n)
+}
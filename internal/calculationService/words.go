@@ -0,0 +1,123 @@
+package calculationService
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Типы машинных слов режима programmer.
+const (
+	WordInt8   = "int8"
+	WordUint8  = "uint8"
+	WordInt16  = "int16"
+	WordUint16 = "uint16"
+	WordInt32  = "int32"
+	WordUint32 = "uint32"
+	WordInt64  = "int64"
+	WordUint64 = "uint64"
+
+	// DefaultWordType — тип слова, если он не задан.
+	DefaultWordType = WordInt64
+)
+
+// ErrInvalidWordType — тип слова не из списка WordInt8..WordUint64.
+var ErrInvalidWordType = errors.New("invalid word type")
+
+// wordType — целое фиксированной ширины: bits бит, со знаком или без.
+// Значения хранятся в uint64 как битовый образ (дополнительный код).
+type wordType struct {
+	bits   uint
+	signed bool
+}
+
+var wordTypes = map[string]wordType{
+	WordInt8: {8, true}, WordUint8: {8, false},
+	WordInt16: {16, true}, WordUint16: {16, false},
+	WordInt32: {32, true}, WordUint32: {32, false},
+	WordInt64: {64, true}, WordUint64: {64, false},
+}
+
+// validateWordType — проверяет тип слова; пустой означает DefaultWordType.
+func validateWordType(name string) (string, error) {
+	if name == "" {
+		return DefaultWordType, nil
+	}
+	if _, ok := wordTypes[name]; !ok {
+		names := make([]string, 0, len(wordTypes))
+		for n := range wordTypes {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("%w: %q (allowed %v)", ErrInvalidWordType, name, names)
+	}
+	return name, nil
+}
+
+// mask — маска младших w.bits бит.
+func (w wordType) mask() uint64 {
+	return ^uint64(0) >> (64 - w.bits)
+}
+
+// norm — x, обрезанное до ширины слова; у знаковых типов старший бит
+// размножается, так что значение можно читать как int64.
+func (w wordType) norm(x uint64) uint64 {
+	x &= w.mask()
+	if w.signed && x>>(w.bits-1)&1 == 1 {
+		x |= ^w.mask()
+	}
+	return x
+}
+
+// negative — отрицательно ли значение знакового типа.
+func (w wordType) negative(x uint64) bool {
+	return w.signed && int64(x) < 0
+}
+
+// format — десятичная запись значения.
+func (w wordType) format(x uint64) string {
+	if w.signed {
+		return strconv.FormatInt(int64(x), 10)
+	}
+	return strconv.FormatUint(x, 10)
+}
+
+// Bases — результат режима programmer в четырёх системах счисления.
+// Отрицательные числа записаны битовым образом в дополнительном коде.
+type Bases struct {
+	Bin string // "0b101"
+	Oct string // "0o5"
+	Dec string // "5"
+	Hex string // "0x5"
+}
+
+// FormatBases — запись результата result типа wordType во всех системах
+// счисления; false — это не результат режима programmer.
+func FormatBases(result, wordType string) (Bases, bool) {
+	w, ok := wordTypes[wordType]
+	if !ok {
+		return Bases{}, false
+	}
+	var x uint64
+	if w.signed {
+		v, err := strconv.ParseInt(result, 10, 64)
+		if err != nil {
+			return Bases{}, false
+		}
+		x = uint64(v)
+	} else {
+		v, err := strconv.ParseUint(result, 10, 64)
+		if err != nil {
+			return Bases{}, false
+		}
+		x = v
+	}
+	bits := x & w.mask()
+	return Bases{
+		Bin: "0b" + strconv.FormatUint(bits, 2),
+		Oct: "0o" + strconv.FormatUint(bits, 8),
+		Dec: w.format(w.norm(x)),
+		Hex: "0x" + strconv.FormatUint(bits, 16),
+	}, true
+}
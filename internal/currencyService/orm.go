@@ -0,0 +1,14 @@
+package currencyService
+
+import "time"
+
+// ExchangeRate — курс валюты, действующий с даты EffectiveDate до
+// следующего курса той же валюты: сколько единиц Currency дают за одну
+// единицу базовой валюты (например, EUR 0.92 при базовой USD).
+type ExchangeRate struct {
+	ID            string    `gorm:"primaryKey" json:"id"`
+	Currency      string    `gorm:"not null;size:3;uniqueIndex:idx_exchange_rates_currency_date" json:"currency"`        // Код ISO 4217, например "EUR"
+	EffectiveDate string    `gorm:"not null;size:10;uniqueIndex:idx_exchange_rates_currency_date" json:"effective_date"` // Дата начала действия, "2006-01-02" (UTC)
+	Rate          string    `gorm:"not null" json:"rate"`                                                                // Десятичная запись курса, например "0.92"
+	CreatedAt     time.Time `json:"created_at"`
+}
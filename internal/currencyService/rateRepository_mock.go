@@ -0,0 +1,24 @@
+package currencyService
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRateRepository — поддельный репозиторий курсов валют
+type MockRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockRateRepository) SaveRates(ctx context.Context, rates []ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockRateRepository) GetRatesAt(ctx context.Context, date string) ([]ExchangeRate, error) {
+	args := m.Called(ctx, date)
+	if res := args.Get(0); res != nil {
+		return res.([]ExchangeRate), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,47 @@
+package currencyService
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RateRepository — интерфейс для хранения курсов валют
+type RateRepository interface {
+	SaveRates(ctx context.Context, rates []ExchangeRate) error
+	GetRatesAt(ctx context.Context, date string) ([]ExchangeRate, error)
+}
+
+type rateRepository struct {
+	db *gorm.DB
+}
+
+// NewRateRepository — конструктор репозитория
+func NewRateRepository(db *gorm.DB) RateRepository {
+	return &rateRepository{db: db}
+}
+
+// SaveRates — сохраняет курсы одной транзакцией; курс той же валюты на ту же
+// дату заменяется новым.
+func (r *rateRepository) SaveRates(ctx context.Context, rates []ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "currency"}, {Name: "effective_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "created_at"}),
+	}).Create(&rates).Error
+}
+
+// GetRatesAt — для каждой валюты последний курс с датой не позже date,
+// отсортированные по коду валюты.
+func (r *rateRepository) GetRatesAt(ctx context.Context, date string) ([]ExchangeRate, error) {
+	var rates []ExchangeRate
+	err := r.db.WithContext(ctx).
+		Where(`effective_date = (SELECT MAX(e.effective_date) FROM exchange_rates e
+			WHERE e.currency = exchange_rates.currency AND e.effective_date <= ?)`, date).
+		Order("currency").
+		Find(&rates).Error
+	return rates, err
+}
@@ -0,0 +1,45 @@
+package currencyService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"CalculatorAppFrontendPantela-main/internal/db/dbtest"
+)
+
+func TestGetRatesAt(t *testing.T) {
+	repo := NewRateRepository(dbtest.New(t))
+	now := time.Now()
+	rate := func(id, currency, date, value string) ExchangeRate {
+		return ExchangeRate{ID: id, Currency: currency, EffectiveDate: date, Rate: value, CreatedAt: now}
+	}
+	require.NoError(t, repo.SaveRates(t.Context(), []ExchangeRate{
+		rate("1", "EUR", "2026-09-01", "0.90"),
+		rate("2", "EUR", "2026-10-01", "0.92"),
+		rate("3", "GBP", "2026-10-15", "0.79"),
+	}))
+	// Тот же день той же валюты заменяет курс.
+	require.NoError(t, repo.SaveRates(t.Context(), []ExchangeRate{rate("4", "EUR", "2026-10-01", "0.93")}))
+
+	tests := []struct {
+		date string
+		want map[string]string
+	}{
+		{"2026-08-31", map[string]string{}},
+		{"2026-09-30", map[string]string{"EUR": "0.90"}},
+		{"2026-10-01", map[string]string{"EUR": "0.93"}},
+		{"2026-10-18", map[string]string{"EUR": "0.93", "GBP": "0.79"}},
+	}
+	for _, tt := range tests {
+		rates, err := repo.GetRatesAt(t.Context(), tt.date)
+		require.NoError(t, err)
+		got := map[string]string{}
+		for _, r := range rates {
+			got[r.Currency] = r.Rate
+		}
+		assert.Equal(t, tt.want, got, tt.date)
+	}
+}
@@ -0,0 +1,161 @@
+package currencyService
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultBase — базовая валюта, если другая не задана: курсы всех валют
+// записываются относительно неё.
+const DefaultBase = "USD"
+
+// ErrInvalidRate — в импортируемых курсах неверный код валюты, дата или курс.
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// codePattern — код валюты ISO 4217.
+var codePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// CurrencyService — интерфейс бизнес-логики курсов валют.
+// Реализует calculationService.RateSource, поэтому сервис вычислений
+// получает курсы прямо отсюда.
+type CurrencyService interface {
+	// ImportRates — проверяет и сохраняет курсы; возвращает число сохранённых.
+	ImportRates(ctx context.Context, rates []ExchangeRate) (int, error)
+	// ImportCSV — то же для CSV с заголовком date,currency,rate.
+	ImportCSV(ctx context.Context, r io.Reader) (int, error)
+	// RatesAt — курсы, действовавшие в момент at, по коду валюты.
+	RatesAt(ctx context.Context, at time.Time) (map[string]string, error)
+	// Base — базовая валюта.
+	Base() string
+}
+
+type currencyService struct {
+	repo RateRepository
+	base string
+}
+
+// NewCurrencyService — конструктор сервиса; пустая base — DefaultBase.
+func NewCurrencyService(repo RateRepository, base string) CurrencyService {
+	if base == "" {
+		base = DefaultBase
+	}
+	return &currencyService{repo: repo, base: base}
+}
+
+func (s *currencyService) Base() string { return s.base }
+
+// ImportRates — сохраняет курсы одной операцией: если хоть один курс
+// неверен, не сохраняется ни один. Повтор валюты и даты в одном импорте
+// оставляет последний курс, курс базовой валюты (только 1) пропускается.
+func (s *currencyService) ImportRates(ctx context.Context, rates []ExchangeRate) (int, error) {
+	if len(rates) == 0 {
+		return 0, fmt.Errorf("%w: no rates to import", ErrInvalidRate)
+	}
+
+	now := time.Now()
+	index := make(map[string]int, len(rates))
+	var batch []ExchangeRate
+	for i, r := range rates {
+		r, err := s.validate(r)
+		if err != nil {
+			return 0, fmt.Errorf("%w: record %d: %v", ErrInvalidRate, i+1, err)
+		}
+		if r.Currency == s.base {
+			continue
+		}
+		r.ID = uuid.NewString()
+		r.CreatedAt = now
+		key := r.Currency + " " + r.EffectiveDate
+		if j, ok := index[key]; ok {
+			batch[j] = r
+			continue
+		}
+		index[key] = len(batch)
+		batch = append(batch, r)
+	}
+
+	if err := s.repo.SaveRates(ctx, batch); err != nil {
+		return 0, err
+	}
+	return len(batch), nil
+}
+
+// validate — курс с нормализованными кодом, датой и значением.
+func (s *currencyService) validate(r ExchangeRate) (ExchangeRate, error) {
+	r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+	if !codePattern.MatchString(r.Currency) {
+		return r, fmt.Errorf("currency %q is not an ISO 4217 code", r.Currency)
+	}
+	date, err := time.Parse(time.DateOnly, strings.TrimSpace(r.EffectiveDate))
+	if err != nil {
+		return r, fmt.Errorf("date %q is not YYYY-MM-DD", r.EffectiveDate)
+	}
+	r.EffectiveDate = date.Format(time.DateOnly)
+	r.Rate = strings.TrimSpace(r.Rate)
+	rate, ok := new(big.Rat).SetString(r.Rate)
+	if !ok || rate.Sign() <= 0 {
+		return r, fmt.Errorf("rate %q is not a positive number", r.Rate)
+	}
+	if r.Currency == s.base && rate.Cmp(big.NewRat(1, 1)) != 0 {
+		return r, fmt.Errorf("base currency %s has rate 1, got %q", s.base, r.Rate)
+	}
+	return r, nil
+}
+
+// ImportCSV — читает курсы из CSV. Первая строка — заголовок с колонками
+// date, currency и rate в любом порядке; другие колонки не читаются.
+func (s *currencyService) ImportCSV(ctx context.Context, r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidRate, err)
+	}
+	if len(records) == 0 {
+		return 0, fmt.Errorf("%w: no rates to import", ErrInvalidRate)
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "currency", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return 0, fmt.Errorf("%w: CSV header has no %q column", ErrInvalidRate, name)
+		}
+	}
+
+	rates := make([]ExchangeRate, 0, len(records)-1)
+	for _, rec := range records[1:] {
+		rates = append(rates, ExchangeRate{
+			EffectiveDate: rec[columns["date"]],
+			Currency:      rec[columns["currency"]],
+			Rate:          rec[columns["rate"]],
+		})
+	}
+	return s.ImportRates(ctx, rates)
+}
+
+// RatesAt — последние курсы с датой не позже дня at (UTC) и курс 1 базовой валюты.
+func (s *currencyService) RatesAt(ctx context.Context, at time.Time) (map[string]string, error) {
+	rates, err := s.repo.GetRatesAt(ctx, at.UTC().Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(rates)+1)
+	for _, r := range rates {
+		values[r.Currency] = r.Rate
+	}
+	values[s.base] = "1"
+	return values, nil
+}
@@ -0,0 +1,95 @@
+package currencyService
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImportCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		csv       string
+		want      []ExchangeRate // курсы, переданные в SaveRates (без ID и CreatedAt)
+		wantErr   error
+		errDetail string
+	}{
+		{
+			name: "курсы в порядке файла",
+			csv:  "date,currency,rate\n2026-10-01,EUR,0.92\n2026-10-01,gbp, 0.79\n",
+			want: []ExchangeRate{
+				{Currency: "EUR", EffectiveDate: "2026-10-01", Rate: "0.92"},
+				{Currency: "GBP", EffectiveDate: "2026-10-01", Rate: "0.79"},
+			},
+		},
+		{
+			name: "колонки в другом порядке и комментарии",
+			csv:  "# ECB\nRate,Date,Currency,Source\n0.92,2026-10-01,EUR,ecb\n",
+			want: []ExchangeRate{{Currency: "EUR", EffectiveDate: "2026-10-01", Rate: "0.92"}},
+		},
+		{
+			name: "повтор валюты и даты — последний курс",
+			csv:  "date,currency,rate\n2026-10-01,EUR,0.90\n2026-10-01,EUR,0.92\n",
+			want: []ExchangeRate{{Currency: "EUR", EffectiveDate: "2026-10-01", Rate: "0.92"}},
+		},
+		{
+			name: "базовая валюта пропускается",
+			csv:  "date,currency,rate\n2026-10-01,USD,1\n2026-10-01,EUR,0.92\n",
+			want: []ExchangeRate{{Currency: "EUR", EffectiveDate: "2026-10-01", Rate: "0.92"}},
+		},
+		{name: "нет колонки", csv: "date,currency\n2026-10-01,EUR\n", wantErr: ErrInvalidRate, errDetail: `no "rate" column`},
+		{name: "пустой файл", csv: "", wantErr: ErrInvalidRate},
+		{name: "только заголовок", csv: "date,currency,rate\n", wantErr: ErrInvalidRate},
+		{name: "не код валюты", csv: "date,currency,rate\n2026-10-01,EURO,0.92\n", wantErr: ErrInvalidRate, errDetail: "record 1"},
+		{name: "неверная дата", csv: "date,currency,rate\n2026-10-01,EUR,0.92\n01.10.2026,GBP,0.79\n", wantErr: ErrInvalidRate, errDetail: "record 2"},
+		{name: "нулевой курс", csv: "date,currency,rate\n2026-10-01,EUR,0\n", wantErr: ErrInvalidRate},
+		{name: "курс базовой валюты не 1", csv: "date,currency,rate\n2026-10-01,USD,2\n", wantErr: ErrInvalidRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockRateRepository)
+			var saved []ExchangeRate
+			mockRepo.On("SaveRates", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).([]ExchangeRate)
+			}).Return(nil).Maybe()
+
+			service := NewCurrencyService(mockRepo, "")
+			n, err := service.ImportCSV(t.Context(), strings.NewReader(tt.csv))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorContains(t, err, tt.errDetail)
+				mockRepo.AssertNotCalled(t, "SaveRates", mock.Anything, mock.Anything)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, len(tt.want), n)
+				for i := range saved {
+					assert.NotEmpty(t, saved[i].ID)
+					saved[i].ID, saved[i].CreatedAt = "", time.Time{}
+				}
+				assert.Equal(t, tt.want, saved)
+			}
+		})
+	}
+}
+
+func TestRatesAt(t *testing.T) {
+	mockRepo := new(MockRateRepository)
+	mockRepo.On("GetRatesAt", mock.Anything, "2026-10-18").Return([]ExchangeRate{
+		{Currency: "EUR", EffectiveDate: "2026-10-01", Rate: "0.92"},
+	}, nil)
+
+	service := NewCurrencyService(mockRepo, "GBP")
+	// 23:30 по Москве — ещё 18 октября по UTC.
+	at := time.Date(2026, 10, 18, 23, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	rates, err := service.RatesAt(t.Context(), at)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"EUR": "0.92", "GBP": "1"}, rates)
+	mockRepo.AssertExpectations(t)
+}
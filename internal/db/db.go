@@ -1,35 +1,113 @@
 package db
 
 import (
+	"errors"
 	"fmt"
-	"log"
+	"io/fs"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/glebarez/sqlite"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+
+	"CalculatorAppFrontendPantela-main/db/migrations"
 )
 
-// ConnectDB — функция для подключения к PostgreSQL.
-func ConnectDB() *gorm.DB {
-	// DSN — строка подключения (можно настроить через переменные окружения)
-	host := getEnv("DB_HOST", "localhost")
-	user := getEnv("DB_USER", "postgres")
-	password := getEnv("DB_PASSWORD", "12345")
-	dbname := getEnv("DB_NAME", "postgres")
-	port := getEnv("DB_PORT", "5432")
-	sslmode := getEnv("DB_SSLMODE", "disable")
+// Поддерживаемые СУБД — значения DB_DRIVER.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
 
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
-		host, user, password, dbname, port, sslmode)
+// ErrUnknownDriver — DB_DRIVER не postgres и не sqlite.
+var ErrUnknownDriver = errors.New("unknown database driver")
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
-	if err != nil {
-		log.Fatalf("❌ Could not connect to database: %v", err)
+// Config — параметры подключения к БД.
+type Config struct {
+	// Driver — DriverPostgres или DriverSQLite.
+	Driver string
+	// DSN — строка подключения PostgreSQL ("host=... user=...") или путь к
+	// файлу SQLite.
+	DSN string
+}
+
+// ConfigFromEnv — параметры подключения из окружения. DB_DRIVER выбирает
+// СУБД (по умолчанию postgres). DB_DSN, если задана, — готовая строка
+// подключения; иначе для PostgreSQL она собирается из DB_HOST, DB_USER,
+// DB_PASSWORD, DB_NAME, DB_PORT и DB_SSLMODE, а для SQLite это DB_PATH
+// (по умолчанию calculator.db).
+func ConfigFromEnv() Config {
+	cfg := Config{Driver: getEnv("DB_DRIVER", DriverPostgres), DSN: os.Getenv("DB_DSN")}
+	if cfg.DSN != "" {
+		return cfg
+	}
+	switch cfg.Driver {
+	case DriverSQLite:
+		cfg.DSN = getEnv("DB_PATH", "calculator.db")
+	default:
+		params := []string{
+			"host=" + getEnv("DB_HOST", "localhost"),
+			"user=" + getEnv("DB_USER", "postgres"),
+			"dbname=" + getEnv("DB_NAME", "postgres"),
+			"port=" + getEnv("DB_PORT", "5432"),
+			"sslmode=" + getEnv("DB_SSLMODE", "disable"),
+		}
+		// Без DB_PASSWORD пароль берётся, как обычно у libpq, из PGPASSWORD
+		// или ~/.pgpass.
+		if password := os.Getenv("DB_PASSWORD"); password != "" {
+			params = append(params, "password="+password)
+		}
+		cfg.DSN = strings.Join(params, " ")
+	}
+	return cfg
+}
+
+// Connect — подключается к БД по cfg.
+func Connect(cfg Config) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	// Нарушение уникальности — gorm.ErrDuplicatedKey в любой СУБД.
+	gormCfg := gorm.Config{TranslateError: true}
+	switch cfg.Driver {
+	case DriverPostgres:
+		dialector = postgres.Open(cfg.DSN)
+	case DriverSQLite:
+		// Ждём, пока другой процесс отпустит файл, вместо ошибки
+		// "database is locked".
+		sep := "?"
+		if strings.Contains(cfg.DSN, "?") {
+			sep = "&"
+		}
+		dialector = sqlite.Open(cfg.DSN + sep + "_pragma=busy_timeout(5000)")
+		// SQLite хранит время текстом и сравнивает его как строки, поэтому
+		// всё время в БД — в одной зоне.
+		gormCfg.NowFunc = func() time.Time { return time.Now().UTC() }
+	default:
+		return nil, fmt.Errorf("%w %q: want %q or %q", ErrUnknownDriver, cfg.Driver, DriverPostgres, DriverSQLite)
 	}
 
-	log.Println("✅ Connected to the database successfully")
+	conn, err := gorm.Open(dialector, &gormCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", cfg.Driver, err)
+	}
+	if cfg.Driver == DriverSQLite {
+		// Писать в SQLite может только одно соединение за раз.
+		sqlDB, err := conn.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+	return conn, nil
+}
 
-	return db
+// Migrations — встроенные миграции для СУБД driver.
+func Migrations(driver string) (fs.FS, error) {
+	if driver != DriverPostgres && driver != DriverSQLite {
+		return nil, fmt.Errorf("%w %q", ErrUnknownDriver, driver)
+	}
+	return fs.Sub(migrations.FS, driver)
 }
 
 func getEnv(key, defaultValue string) string {
@@ -0,0 +1,41 @@
+// Package dbtest — БД для интеграционных тестов репозиториев.
+package dbtest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"CalculatorAppFrontendPantela-main/internal/db"
+)
+
+// New — пустая БД SQLite во временном каталоге теста со всеми миграциями.
+// БД закрывается по окончании теста.
+func New(t testing.TB) *gorm.DB {
+	t.Helper()
+	conn, err := db.Connect(db.Config{Driver: db.DriverSQLite, DSN: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	conn.Logger = logger.Discard
+	sqlDB, err := conn.DB()
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	dir, err := db.Migrations(db.DriverSQLite)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	migrator, err := db.NewMigrator(conn, dir)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+	return conn
+}
@@ -0,0 +1,264 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrDirty — предыдущая миграция прервалась на полпути (так отмечает
+	// golang-migrate); схему нужно проверить вручную и выполнить force.
+	ErrDirty = errors.New("database schema is dirty")
+	// ErrUnknownVersion — версия схемы в БД не совпадает ни с одной из
+	// известных миграций: БД обновлял более новый бинарник или чужой инструмент.
+	ErrUnknownVersion = errors.New("unknown database schema version")
+	// ErrPendingMigrations — в БД применены не все миграции.
+	ErrPendingMigrations = errors.New("database schema is out of date")
+)
+
+// versionTable — таблица версии схемы в формате golang-migrate: одна
+// строка с версией последней применённой миграции.
+const versionTable = "schema_migrations"
+
+// Migration — миграция схемы: SQL применения и отката.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus — миграция и применена ли она.
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// migrationFile — имя файла миграции: <версия>_<имя>.up.sql или .down.sql.
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// LoadMigrations — миграции из корня fsys по возрастанию версии. У каждой
+// миграции должны быть оба файла; прочие файлы пропускаются.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		m := migrationFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || m == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version", entry.Name())
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		mig := byVersion[version]
+		if mig == nil {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d: conflicting names %q and %q", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(data)
+		} else {
+			mig.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s: both .up.sql and .down.sql are required", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator — применяет и откатывает миграции. Каждая миграция выполняется
+// в транзакции вместе с записью новой версии, поэтому прерванная миграция
+// не оставляет схему наполовину изменённой.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator — мигратор БД db с миграциями из fsys.
+func NewMigrator(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// legacyVersions — версии golang-migrate из прежних db/migrations/*.sql
+// (init_calculations и add_user_id_to_tasks). Такую схему приводят к
+// текущей все миграции, начиная с базовой, поэтому для мигратора это версия
+// 0. add_user_id_to_tasks меняла несуществующую таблицу tasks и оставляла
+// отметку dirty, но её единственная команда ничего не изменила.
+var legacyVersions = map[int64]bool{20251030081532: true, 20251105155900: true}
+
+// Version — версия схемы в БД; 0 — миграции не применялись (или
+// применялись только прежние миграции golang-migrate, см. legacyVersions).
+func (m *Migrator) Version() (version int64, dirty bool, err error) {
+	if err := m.db.Exec("CREATE TABLE IF NOT EXISTS " + versionTable + " (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)").Error; err != nil {
+		return 0, false, err
+	}
+	var rows []struct {
+		Version int64
+		Dirty   bool
+	}
+	if err := m.db.Raw("SELECT version, dirty FROM " + versionTable).Scan(&rows).Error; err != nil {
+		return 0, false, err
+	}
+	if len(rows) == 0 || legacyVersions[rows[0].Version] {
+		return 0, false, nil
+	}
+	return rows[0].Version, rows[0].Dirty, nil
+}
+
+// Status — версия схемы в БД и все известные миграции.
+func (m *Migrator) Status() (version int64, dirty bool, migrations []MigrationStatus, err error) {
+	if version, dirty, err = m.Version(); err != nil {
+		return 0, false, nil, err
+	}
+	for _, mig := range m.migrations {
+		migrations = append(migrations, MigrationStatus{Migration: mig, Applied: mig.Version <= version})
+	}
+	return version, dirty, migrations, nil
+}
+
+// Check — проверяет, что схема в БД — последняя из известных миграций:
+// иначе ErrDirty, ErrUnknownVersion или ErrPendingMigrations.
+func (m *Migrator) Check() error {
+	version, pos, err := m.current()
+	if err != nil {
+		return err
+	}
+	if pending := len(m.migrations) - pos - 1; pending > 0 {
+		return fmt.Errorf("%w: version %d, %d migration(s) pending", ErrPendingMigrations, version, pending)
+	}
+	return nil
+}
+
+// Up — применяет все ещё не применённые миграции; возвращает их число.
+func (m *Migrator) Up() (int, error) {
+	_, pos, err := m.current()
+	if err != nil {
+		return 0, err
+	}
+	applied := 0
+	for _, mig := range m.migrations[pos+1:] {
+		if err := m.run(mig.Up, mig.Version); err != nil {
+			return applied, fmt.Errorf("migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+// Down — откатывает steps последних применённых миграций (не больше, чем
+// применено); возвращает число откаченных.
+func (m *Migrator) Down(steps int) (int, error) {
+	_, pos, err := m.current()
+	if err != nil {
+		return 0, err
+	}
+	reverted := 0
+	for ; reverted < steps && pos >= 0; pos-- {
+		mig := m.migrations[pos]
+		var prev int64
+		if pos > 0 {
+			prev = m.migrations[pos-1].Version
+		}
+		if err := m.run(mig.Down, prev); err != nil {
+			return reverted, fmt.Errorf("migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		reverted++
+	}
+	return reverted, nil
+}
+
+// Force — записывает версию схемы, ничего не выполняя, и снимает отметку
+// dirty. Нужна, когда схему привели к версии version вручную. Версия 0 —
+// миграции не применялись.
+func (m *Migrator) Force(version int64) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	if _, _, err := m.Version(); err != nil {
+		return err
+	}
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		return setVersion(tx, version)
+	})
+}
+
+// current — версия схемы и её позиция в m.migrations (-1 — миграции не
+// применялись). Грязная или неизвестная версия — ошибка.
+func (m *Migrator) current() (int64, int, error) {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return 0, 0, err
+	}
+	if dirty {
+		return 0, 0, fmt.Errorf("%w at version %d: fix the schema and run \"migrate force\"", ErrDirty, version)
+	}
+	if version == 0 {
+		return 0, -1, nil
+	}
+	pos := m.index(version)
+	if pos < 0 {
+		return 0, 0, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	return version, pos, nil
+}
+
+// index — позиция миграции version в m.migrations; -1, если её нет.
+func (m *Migrator) index(version int64) int {
+	for i, mig := range m.migrations {
+		if mig.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// run — выполняет sql и записывает версию схемы version в одной транзакции.
+func (m *Migrator) run(sql string, version int64) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(sql).Error; err != nil {
+			return err
+		}
+		return setVersion(tx, version)
+	})
+}
+
+// setVersion — делает version текущей версией схемы; 0 — миграций нет.
+func setVersion(tx *gorm.DB, version int64) error {
+	if err := tx.Exec("DELETE FROM " + versionTable).Error; err != nil {
+		return err
+	}
+	if version == 0 {
+		return nil
+	}
+	return tx.Exec("INSERT INTO "+versionTable+" (version, dirty) VALUES (?, ?)", version, false).Error
+}
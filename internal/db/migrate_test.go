@@ -0,0 +1,84 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	file := func(s string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(s)} }
+
+	tests := []struct {
+		name     string
+		fsys     fstest.MapFS
+		versions []int64
+		wantErr  string
+	}{
+		{
+			name: "по возрастанию версии",
+			fsys: fstest.MapFS{
+				"2_second.up.sql":   file("B"),
+				"2_second.down.sql": file("b"),
+				"1_first.up.sql":    file("A"),
+				"1_first.down.sql":  file("a"),
+				"Makefile":          file(""),
+			},
+			versions: []int64{1, 2},
+		},
+		{
+			name:    "нет отката",
+			fsys:    fstest.MapFS{"1_first.up.sql": file("A")},
+			wantErr: "both .up.sql and .down.sql are required",
+		},
+		{
+			name:    "разные имена одной версии",
+			fsys:    fstest.MapFS{"1_first.up.sql": file("A"), "1_other.down.sql": file("a")},
+			wantErr: "conflicting names",
+		},
+		{
+			name:    "нулевая версия",
+			fsys:    fstest.MapFS{"0_zero.up.sql": file("A"), "0_zero.down.sql": file("a")},
+			wantErr: "invalid version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadMigrations(tt.fsys)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			var versions []int64
+			for _, m := range got {
+				versions = append(versions, m.Version)
+			}
+			assert.Equal(t, tt.versions, versions)
+			assert.Equal(t, Migration{Version: 1, Name: "first", Up: "A", Down: "a"}, got[0])
+		})
+	}
+}
+
+// TestEmbeddedMigrations — у каждой СУБД одни и те же миграции, иначе
+// версии схем разошлись бы.
+func TestEmbeddedMigrations(t *testing.T) {
+	names := func(driver string) []string {
+		dir, err := Migrations(driver)
+		require.NoError(t, err)
+		all, err := LoadMigrations(dir)
+		require.NoError(t, err)
+		var names []string
+		for _, m := range all {
+			names = append(names, fmt.Sprintf("%d_%s", m.Version, m.Name))
+		}
+		return names
+	}
+	postgres := names(DriverPostgres)
+	assert.NotEmpty(t, postgres)
+	assert.Equal(t, postgres, names(DriverSQLite))
+}
@@ -0,0 +1,287 @@
+package db_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	"CalculatorAppFrontendPantela-main/internal/authService"
+	"CalculatorAppFrontendPantela-main/internal/calculationService"
+	"CalculatorAppFrontendPantela-main/internal/currencyService"
+	"CalculatorAppFrontendPantela-main/internal/db"
+	"CalculatorAppFrontendPantela-main/internal/functionService"
+	"CalculatorAppFrontendPantela-main/internal/userService"
+	"CalculatorAppFrontendPantela-main/internal/variableService"
+)
+
+// models — модели, таблицы которых создают миграции.
+var models = []interface{}{
+	&calculationService.Calculation{},
+	&calculationService.Revision{},
+	&userService.User{},
+	&authService.RefreshToken{},
+	&variableService.Variable{},
+	&functionService.UserFunction{},
+	&currencyService.ExchangeRate{},
+}
+
+// TestSchemaMatchesModels — миграции, применённые к пустой схеме, создают
+// ровно те таблицы, колонки и индексы, которые описывают модели, а откат
+// всех миграций удаляет их. Нужен PostgreSQL: TEST_POSTGRES_DSN, например
+// "host=localhost user=postgres password=12345 dbname=postgres sslmode=disable".
+// Тест работает в отдельной временной схеме.
+func TestSchemaMatchesModels(t *testing.T) {
+	conn := postgresSchema(t)
+	dir, err := db.Migrations(db.DriverPostgres)
+	require.NoError(t, err)
+	checkMigrations(t, conn, dir)
+}
+
+// postgresSchema — подключение к PostgreSQL из TEST_POSTGRES_DSN, работающее
+// в отдельной временной схеме; без TEST_POSTGRES_DSN тест пропускается.
+func postgresSchema(t *testing.T) *gorm.DB {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	admin, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	name := fmt.Sprintf("schema_test_%d", time.Now().UnixNano())
+	require.NoError(t, admin.Exec("CREATE SCHEMA "+name).Error)
+	t.Cleanup(func() { admin.Exec("DROP SCHEMA " + name + " CASCADE") })
+
+	conn, err := gorm.Open(postgres.Open(dsn+" search_path="+name), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	return conn
+}
+
+// TestLegacySchemaMigrates — миграции приводят к схеме моделей таблицы,
+// созданные до встроенных миграций, и сохраняют их строки. Нужен
+// PostgreSQL, как для TestSchemaMatchesModels.
+func TestLegacySchemaMigrates(t *testing.T) {
+	tests := []struct {
+		name   string
+		legacy []string
+		// skipLegacy — до полного обновления схему обновил бинарник без
+		// миграции legacy_schema.
+		skipLegacy bool
+	}{
+		{
+			name: "golang-migrate",
+			legacy: []string{
+				"CREATE TABLE calculations (id SERIAL PRIMARY KEY, expression VARCHAR(255) NOT NULL, result FLOAT NOT NULL, created_at TIMESTAMP DEFAULT NOW())",
+				"INSERT INTO calculations (expression, result) VALUES ('2+2', 4)",
+				"CREATE TABLE schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)",
+				"INSERT INTO schema_migrations (version, dirty) VALUES (20251105155900, true)",
+			},
+		},
+		{
+			name: "AutoMigrate",
+			legacy: []string{
+				"CREATE TABLE calculations (id text PRIMARY KEY, expression text, result text, user_id text)",
+				"CREATE INDEX idx_calculations_user_id ON calculations (user_id)",
+				"INSERT INTO calculations (id, expression, result) VALUES ('1', '2+2', '4')",
+				"CREATE TABLE users (id text PRIMARY KEY, email text NOT NULL CONSTRAINT uni_users_email UNIQUE, password text NOT NULL, deleted_at timestamptz, created_at timestamptz, updated_at timestamptz)",
+			},
+		},
+		{
+			name: "AutoMigrate, затем миграции без legacy_schema",
+			legacy: []string{
+				"CREATE TABLE calculations (id text PRIMARY KEY, expression text, result text, user_id text)",
+				"INSERT INTO calculations (id, expression, result) VALUES ('1', '2+2', '4')",
+			},
+			skipLegacy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := postgresSchema(t)
+			for _, sql := range tt.legacy {
+				require.NoError(t, conn.Exec(sql).Error)
+			}
+			dir, err := db.Migrations(db.DriverPostgres)
+			require.NoError(t, err)
+
+			if tt.skipLegacy {
+				older, err := db.NewMigrator(conn, withoutMigration(t, dir, "legacy_schema"))
+				require.NoError(t, err)
+				_, err = older.Up()
+				require.NoError(t, err)
+			}
+			migrator, err := db.NewMigrator(conn, dir)
+			require.NoError(t, err)
+			_, err = migrator.Up()
+			require.NoError(t, err)
+			require.NoError(t, migrator.Check())
+
+			for _, model := range models {
+				checkTable(t, conn, model)
+			}
+			var calc calculationService.Calculation
+			require.NoError(t, conn.First(&calc, "id = ?", "1").Error)
+			assert.Equal(t, "2+2", calc.Expression)
+			assert.Equal(t, "4", calc.Result)
+		})
+	}
+}
+
+// TestLegacyVersion — версия прежних миграций golang-migrate, даже с
+// отметкой dirty, означает «миграции не применялись».
+func TestLegacyVersion(t *testing.T) {
+	conn, err := db.Connect(db.Config{Driver: db.DriverSQLite, DSN: filepath.Join(t.TempDir(), "legacy.db")})
+	require.NoError(t, err)
+	conn.Logger = logger.Discard
+	require.NoError(t, conn.Exec("CREATE TABLE schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)").Error)
+	require.NoError(t, conn.Exec("INSERT INTO schema_migrations (version, dirty) VALUES (20251105155900, true)").Error)
+
+	dir, err := db.Migrations(db.DriverSQLite)
+	require.NoError(t, err)
+	migrator, err := db.NewMigrator(conn, dir)
+	require.NoError(t, err)
+
+	version, dirty, err := migrator.Version()
+	require.NoError(t, err)
+	assert.Zero(t, version)
+	assert.False(t, dirty)
+
+	_, err = migrator.Up()
+	require.NoError(t, err)
+	assert.NoError(t, migrator.Check())
+}
+
+// withoutMigration — dir без файлов миграции name.
+func withoutMigration(t *testing.T, dir fs.FS, name string) fs.FS {
+	entries, err := fs.ReadDir(dir, ".")
+	require.NoError(t, err)
+	out := fstest.MapFS{}
+	for _, entry := range entries {
+		if strings.Contains(entry.Name(), "_"+name+".") {
+			continue
+		}
+		data, err := fs.ReadFile(dir, entry.Name())
+		require.NoError(t, err)
+		out[entry.Name()] = &fstest.MapFile{Data: data}
+	}
+	return out
+}
+
+// TestSQLiteSchemaMatchesModels — то же для миграций SQLite на временном файле.
+func TestSQLiteSchemaMatchesModels(t *testing.T) {
+	conn, err := db.Connect(db.Config{Driver: db.DriverSQLite, DSN: filepath.Join(t.TempDir(), "schema.db")})
+	require.NoError(t, err)
+	conn.Logger = logger.Discard
+	dir, err := db.Migrations(db.DriverSQLite)
+	require.NoError(t, err)
+	checkMigrations(t, conn, dir)
+}
+
+// checkMigrations — применяет все миграции из dir к пустой БД conn, сверяет
+// схему с моделями и откатывает миграции.
+func checkMigrations(t *testing.T, conn *gorm.DB, dir fs.FS) {
+	migrator, err := db.NewMigrator(conn, dir)
+	require.NoError(t, err)
+	n, err := migrator.Up()
+	require.NoError(t, err)
+	require.NoError(t, migrator.Check())
+
+	for _, model := range models {
+		checkTable(t, conn, model)
+	}
+
+	reverted, err := migrator.Down(n)
+	require.NoError(t, err)
+	assert.Equal(t, n, reverted)
+	for _, model := range models {
+		assert.False(t, conn.Migrator().HasTable(model), "%T: table left after migrating down", model)
+	}
+}
+
+// checkTable — таблица модели есть, и её колонки и индексы совпадают с моделью.
+func checkTable(t *testing.T, conn *gorm.DB, model interface{}) {
+	stmt := &gorm.Statement{DB: conn}
+	require.NoError(t, stmt.Parse(model))
+	s := stmt.Schema
+	if !assert.True(t, conn.Migrator().HasTable(model), "table %s is missing", s.Table) {
+		return
+	}
+
+	columnTypes, err := conn.Migrator().ColumnTypes(model)
+	require.NoError(t, err)
+	columns := make(map[string]gorm.ColumnType, len(columnTypes))
+	for _, c := range columnTypes {
+		columns[c.Name()] = c
+	}
+
+	for _, field := range s.Fields {
+		if field.DBName == "" {
+			continue
+		}
+		c, ok := columns[field.DBName]
+		if !assert.True(t, ok, "%s.%s: column is missing", s.Table, field.DBName) {
+			continue
+		}
+		delete(columns, field.DBName)
+
+		family := typeFamily(field)
+		assert.True(t, family[strings.ToLower(c.DatabaseTypeName())],
+			"%s.%s: column type %s does not fit %s", s.Table, field.DBName, c.DatabaseTypeName(), field.DataType)
+		// Первичный ключ NOT NULL и без явного ограничения.
+		if nullable, ok := c.Nullable(); ok && !field.PrimaryKey {
+			assert.Equal(t, !field.NotNull, nullable, "%s.%s: nullability", s.Table, field.DBName)
+		}
+		if field.Size > 0 && field.DataType == schema.String {
+			if length, ok := c.Length(); ok {
+				assert.Equal(t, int64(field.Size), length, "%s.%s: length", s.Table, field.DBName)
+			}
+		}
+	}
+	for name := range columns {
+		t.Errorf("%s.%s: column has no model field", s.Table, name)
+	}
+
+	for _, idx := range s.ParseIndexes() {
+		assert.True(t, conn.Migrator().HasIndex(model, idx.Name), "%s: index %s is missing", s.Table, idx.Name)
+	}
+	for _, field := range s.Fields {
+		if field.Unique {
+			assert.True(t, conn.Migrator().HasConstraint(model, "uni_"+s.Table+"_"+field.DBName) ||
+				conn.Migrator().HasIndex(model, "uni_"+s.Table+"_"+field.DBName),
+				"%s.%s: unique constraint is missing", s.Table, field.DBName)
+		}
+	}
+}
+
+// typeFamily — имена типов колонок, подходящих полю модели. Для типов со
+// своим GormDataType (например, Bindings) это само имя типа.
+func typeFamily(field *schema.Field) map[string]bool {
+	names := map[schema.DataType][]string{
+		schema.String: {"text", "varchar", "character varying", "bpchar"},
+		schema.Int:    {"int2", "int4", "int8", "smallint", "integer", "bigint"},
+		schema.Uint:   {"int2", "int4", "int8", "smallint", "integer", "bigint"},
+		schema.Float:  {"numeric", "decimal", "float4", "float8", "real", "double precision"},
+		schema.Bool:   {"bool", "boolean"},
+		schema.Time:   {"timestamptz", "timestamp", "datetime"},
+	}[field.DataType]
+	if names == nil {
+		names = []string{string(field.DataType)}
+	}
+	family := make(map[string]bool, len(names))
+	for _, n := range names {
+		family[n] = true
+	}
+	return family
+}
@@ -0,0 +1,54 @@
+package functionService
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+// MockFunctionRepository — поддельный репозиторий функций
+type MockFunctionRepository struct {
+	mock.Mock
+}
+
+func (m *MockFunctionRepository) CreateFunction(ctx context.Context, f UserFunction) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockFunctionRepository) GetFunctionsForUser(ctx context.Context, userID string) ([]UserFunction, error) {
+	args := m.Called(ctx, userID)
+	if res := args.Get(0); res != nil {
+		return res.([]UserFunction), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockFunctionRepository) GetFunction(ctx context.Context, userID, name string) (UserFunction, error) {
+	args := m.Called(ctx, userID, name)
+	return args.Get(0).(UserFunction), args.Error(1)
+}
+
+func (m *MockFunctionRepository) UpdateFunction(ctx context.Context, f UserFunction) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockFunctionRepository) DeleteFunction(ctx context.Context, userID, name string) error {
+	args := m.Called(ctx, userID, name)
+	return args.Error(0)
+}
+
+// MockCalculationLister — поддельный источник сохранённых вычислений
+type MockCalculationLister struct {
+	mock.Mock
+}
+
+func (m *MockCalculationLister) GetAllCalculationsForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error) {
+	args := m.Called(ctx, userID)
+	if res := args.Get(0); res != nil {
+		return res.([]calculationService.Calculation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,39 @@
+package functionService
+
+import (
+	"strings"
+	"time"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+// UserFunction — функция пользователя, доступная в его выражениях
+// (например, f(x, y) = x^2 + y).
+type UserFunction struct {
+	ID          string    `gorm:"primaryKey" json:"id"`
+	UserID      string    `gorm:"not null;uniqueIndex:idx_functions_user_name" json:"user_id"`      // Владелец функции
+	Name        string    `gorm:"not null;size:64;uniqueIndex:idx_functions_user_name" json:"name"` // Имя, уникальное в пределах пользователя
+	Params      string    `gorm:"not null" json:"params"`                                           // Имена параметров через запятую
+	Body        string    `gorm:"not null" json:"body"`                                             // Тело функции, например "x^2 + y"
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ParamList — имена параметров по порядку.
+func (f UserFunction) ParamList() []string {
+	if f.Params == "" {
+		return []string{}
+	}
+	return strings.Split(f.Params, ",")
+}
+
+// Definition — функция в виде, понятном сервису вычислений.
+func (f UserFunction) Definition() calculationService.FunctionDefinition {
+	return calculationService.FunctionDefinition{
+		Name:        f.Name,
+		Params:      f.ParamList(),
+		Body:        f.Body,
+		Description: f.Description,
+	}
+}
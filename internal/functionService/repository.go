@@ -0,0 +1,69 @@
+package functionService
+
+import (
+	"context"
+	"gorm.io/gorm"
+)
+
+// FunctionRepository — интерфейс для хранения функций пользователей
+type FunctionRepository interface {
+	CreateFunction(ctx context.Context, f UserFunction) error
+	GetFunctionsForUser(ctx context.Context, userID string) ([]UserFunction, error)
+	GetFunction(ctx context.Context, userID, name string) (UserFunction, error)
+	UpdateFunction(ctx context.Context, f UserFunction) error
+	DeleteFunction(ctx context.Context, userID, name string) error
+}
+
+type functionRepository struct {
+	db *gorm.DB
+}
+
+// NewFunctionRepository — конструктор репозитория
+func NewFunctionRepository(db *gorm.DB) FunctionRepository {
+	return &functionRepository{db: db}
+}
+
+func (r *functionRepository) CreateFunction(ctx context.Context, f UserFunction) error {
+	return r.db.WithContext(ctx).Create(&f).Error
+}
+
+// GetFunctionsForUser — функции пользователя, отсортированные по имени.
+func (r *functionRepository) GetFunctionsForUser(ctx context.Context, userID string) ([]UserFunction, error) {
+	var funcs []UserFunction
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("name").Find(&funcs).Error
+	return funcs, err
+}
+
+func (r *functionRepository) GetFunction(ctx context.Context, userID, name string) (UserFunction, error) {
+	var f UserFunction
+	err := r.db.WithContext(ctx).First(&f, "user_id = ? AND name = ?", userID, name).Error
+	return f, err
+}
+
+// UpdateFunction — обновляет параметры, тело и описание; если записи нет, возвращает gorm.ErrRecordNotFound.
+func (r *functionRepository) UpdateFunction(ctx context.Context, f UserFunction) error {
+	res := r.db.WithContext(ctx).Model(&UserFunction{}).Where("id = ?", f.ID).Updates(map[string]interface{}{
+		"params":      f.Params,
+		"body":        f.Body,
+		"description": f.Description,
+		"updated_at":  f.UpdatedAt,
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *functionRepository) DeleteFunction(ctx context.Context, userID, name string) error {
+	res := r.db.WithContext(ctx).Where("user_id = ? AND name = ?", userID, name).Delete(&UserFunction{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package functionService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+// MaxNameLength — максимальная длина имени функции.
+const MaxNameLength = 64
+
+var (
+	// ErrFunctionNotFound — у пользователя нет функции с таким именем.
+	ErrFunctionNotFound = errors.New("function not found")
+	// ErrFunctionExists — у пользователя уже есть функция с таким именем.
+	ErrFunctionExists = errors.New("function already exists")
+	// ErrFunctionInUse — функцию вызывают другие функции или сохранённые вычисления.
+	ErrFunctionInUse = errors.New("function is in use")
+)
+
+// CalculationLister — сохранённые вычисления пользователя; по ним проверяется,
+// можно ли удалить функцию. Реализуется calculationService.CalculationRepository.
+type CalculationLister interface {
+	GetAllCalculationsForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error)
+}
+
+// FunctionService — интерфейс бизнес-логики функций пользователя.
+// Реализует calculationService.FunctionSource, поэтому сервис вычислений
+// получает определения функций прямо отсюда.
+type FunctionService interface {
+	CreateFunction(ctx context.Context, userID, name string, params []string, body, description string) (UserFunction, error)
+	ListFunctions(ctx context.Context, userID string) ([]UserFunction, error)
+	GetFunction(ctx context.Context, userID, name string) (UserFunction, error)
+	UpdateFunction(ctx context.Context, userID, name string, params *[]string, body, description *string) (UserFunction, error)
+	DeleteFunction(ctx context.Context, userID, name string) error
+	FunctionsForUser(ctx context.Context, userID string) ([]calculationService.FunctionDefinition, error)
+}
+
+type functionService struct {
+	repo         FunctionRepository
+	calculations CalculationLister
+}
+
+// NewFunctionService — конструктор сервиса
+func NewFunctionService(repo FunctionRepository, calculations CalculationLister) FunctionService {
+	return &functionService{repo: repo, calculations: calculations}
+}
+
+// validate — проверяет набор функций пользователя, в котором f заменяет
+// одноимённую функцию или добавляется к остальным.
+func (s *functionService) validate(ctx context.Context, userID string, f UserFunction) error {
+	if len(f.Name) > MaxNameLength {
+		return fmt.Errorf("%w: name %q is too long", calculationService.ErrInvalidDefinition, f.Name)
+	}
+	if utf8.RuneCountInString(f.Body) > calculationService.MaxExpressionLength {
+		return fmt.Errorf("%w: body is longer than %d characters", calculationService.ErrInvalidDefinition, calculationService.MaxExpressionLength)
+	}
+	defs, err := s.FunctionsForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	set := []calculationService.FunctionDefinition{f.Definition()}
+	for _, d := range defs {
+		if d.Name != f.Name {
+			set = append(set, d)
+		}
+	}
+	return calculationService.ValidateFunctions(set)
+}
+
+func (s *functionService) CreateFunction(ctx context.Context, userID, name string, params []string, body, description string) (UserFunction, error) {
+	if _, err := s.repo.GetFunction(ctx, userID, name); err == nil {
+		return UserFunction{}, ErrFunctionExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return UserFunction{}, err
+	}
+
+	now := time.Now()
+	f := UserFunction{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		Name:        name,
+		Params:      strings.Join(params, ","),
+		Body:        body,
+		Description: description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.validate(ctx, userID, f); err != nil {
+		return UserFunction{}, err
+	}
+	if err := s.repo.CreateFunction(ctx, f); err != nil {
+		return UserFunction{}, err
+	}
+
+	return f, nil
+}
+
+func (s *functionService) ListFunctions(ctx context.Context, userID string) ([]UserFunction, error) {
+	return s.repo.GetFunctionsForUser(ctx, userID)
+}
+
+func (s *functionService) GetFunction(ctx context.Context, userID, name string) (UserFunction, error) {
+	f, err := s.repo.GetFunction(ctx, userID, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return UserFunction{}, ErrFunctionNotFound
+	}
+	return f, err
+}
+
+// UpdateFunction — меняет только переданные поля. Сохранённые вычисления
+// хранят прежнее определение и не пересчитываются.
+func (s *functionService) UpdateFunction(ctx context.Context, userID, name string, params *[]string, body, description *string) (UserFunction, error) {
+	f, err := s.GetFunction(ctx, userID, name)
+	if err != nil {
+		return UserFunction{}, err
+	}
+
+	if params != nil {
+		f.Params = strings.Join(*params, ",")
+	}
+	if body != nil {
+		f.Body = *body
+	}
+	if description != nil {
+		f.Description = *description
+	}
+	if err := s.validate(ctx, userID, f); err != nil {
+		return UserFunction{}, err
+	}
+
+	f.UpdatedAt = time.Now()
+	if err := s.repo.UpdateFunction(ctx, f); errors.Is(err, gorm.ErrRecordNotFound) {
+		return UserFunction{}, ErrFunctionNotFound
+	} else if err != nil {
+		return UserFunction{}, err
+	}
+
+	return f, nil
+}
+
+// DeleteFunction — удаляет функцию, если её не вызывают другие функции
+// пользователя и она не встречается в его сохранённых вычислениях.
+func (s *functionService) DeleteFunction(ctx context.Context, userID, name string) error {
+	funcs, err := s.repo.GetFunctionsForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	found := false
+	rest := make([]calculationService.FunctionDefinition, 0, len(funcs))
+	for _, f := range funcs {
+		if f.Name == name {
+			found = true
+			continue
+		}
+		rest = append(rest, f.Definition())
+	}
+	if !found {
+		return ErrFunctionNotFound
+	}
+	if err := calculationService.ValidateFunctions(rest); errors.Is(err, calculationService.ErrUnknownFunction) {
+		return fmt.Errorf("%w: %v", ErrFunctionInUse, err)
+	}
+
+	if s.calculations != nil {
+		calcs, err := s.calculations.GetAllCalculationsForUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, calc := range calcs {
+			if _, used := calc.Functions[name]; used {
+				return fmt.Errorf("%w: calculation %s calls %s", ErrFunctionInUse, calc.ID, name)
+			}
+		}
+	}
+
+	err = s.repo.DeleteFunction(ctx, userID, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrFunctionNotFound
+	}
+	return err
+}
+
+// FunctionsForUser — определения всех функций пользователя.
+func (s *functionService) FunctionsForUser(ctx context.Context, userID string) ([]calculationService.FunctionDefinition, error) {
+	funcs, err := s.repo.GetFunctionsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	defs := make([]calculationService.FunctionDefinition, len(funcs))
+	for i, f := range funcs {
+		defs[i] = f.Definition()
+	}
+	return defs, nil
+}
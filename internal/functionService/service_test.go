@@ -0,0 +1,166 @@
+package functionService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+func TestCreateFunction(t *testing.T) {
+	existing := []UserFunction{{Name: "sq", Params: "x", Body: "x^2"}}
+
+	tests := []struct {
+		name      string
+		fnName    string
+		params    []string
+		body      string
+		mockSetup func(m *MockFunctionRepository)
+		wantErr   error
+	}{
+		{
+			name:   "успешное создание",
+			fnName: "f",
+			params: []string{"x", "y"},
+			body:   "sq(x) + y",
+			mockSetup: func(m *MockFunctionRepository) {
+				m.On("GetFunction", mock.Anything, "alice", "f").Return(UserFunction{}, gorm.ErrRecordNotFound)
+				m.On("GetFunctionsForUser", mock.Anything, "alice").Return(existing, nil)
+				m.On("CreateFunction", mock.Anything, mock.MatchedBy(func(f UserFunction) bool {
+					return f.UserID == "alice" && f.Name == "f" && f.Params == "x,y" && f.ID != ""
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "имя уже занято",
+			fnName: "sq",
+			params: []string{"x"},
+			body:   "x*x",
+			mockSetup: func(m *MockFunctionRepository) {
+				m.On("GetFunction", mock.Anything, "alice", "sq").Return(existing[0], nil)
+			},
+			wantErr: ErrFunctionExists,
+		},
+		{
+			name:   "рекурсия",
+			fnName: "f",
+			params: []string{"x"},
+			body:   "f(x - 1)",
+			mockSetup: func(m *MockFunctionRepository) {
+				m.On("GetFunction", mock.Anything, "alice", "f").Return(UserFunction{}, gorm.ErrRecordNotFound)
+				m.On("GetFunctionsForUser", mock.Anything, "alice").Return(existing, nil)
+			},
+			wantErr: calculationService.ErrRecursion,
+		},
+		{
+			name:   "неверная арность",
+			fnName: "f",
+			params: []string{"x"},
+			body:   "sq(x, 2)",
+			mockSetup: func(m *MockFunctionRepository) {
+				m.On("GetFunction", mock.Anything, "alice", "f").Return(UserFunction{}, gorm.ErrRecordNotFound)
+				m.On("GetFunctionsForUser", mock.Anything, "alice").Return(existing, nil)
+			},
+			wantErr: calculationService.ErrArity,
+		},
+		{
+			name:   "имя встроенной функции",
+			fnName: "sin",
+			params: []string{"x"},
+			body:   "x",
+			mockSetup: func(m *MockFunctionRepository) {
+				m.On("GetFunction", mock.Anything, "alice", "sin").Return(UserFunction{}, gorm.ErrRecordNotFound)
+				m.On("GetFunctionsForUser", mock.Anything, "alice").Return(existing, nil)
+			},
+			wantErr: calculationService.ErrInvalidDefinition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockFunctionRepository)
+			tt.mockSetup(mockRepo)
+
+			service := NewFunctionService(mockRepo, nil)
+			f, err := service.CreateFunction(t.Context(), "alice", tt.fnName, tt.params, tt.body, "")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.params, f.ParamList())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateFunctionCreatesCycle(t *testing.T) {
+	mockRepo := new(MockFunctionRepository)
+	funcs := []UserFunction{
+		{Name: "f", Params: "x", Body: "g(x) + 1"},
+		{Name: "g", Params: "x", Body: "x * 2"},
+	}
+	mockRepo.On("GetFunction", mock.Anything, "alice", "g").Return(funcs[1], nil)
+	mockRepo.On("GetFunctionsForUser", mock.Anything, "alice").Return(funcs, nil)
+
+	body := "f(x)"
+	service := NewFunctionService(mockRepo, nil)
+	_, err := service.UpdateFunction(t.Context(), "alice", "g", nil, &body, nil)
+
+	assert.ErrorIs(t, err, calculationService.ErrRecursion)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteFunction(t *testing.T) {
+	funcs := []UserFunction{
+		{Name: "f", Params: "x", Body: "g(x) + 1"},
+		{Name: "g", Params: "x", Body: "x * 2"},
+		{Name: "h", Params: "", Body: "42"},
+	}
+
+	tests := []struct {
+		name    string
+		fnName  string
+		calcs   []calculationService.Calculation
+		deleted bool
+		wantErr error
+	}{
+		{name: "успешное удаление", fnName: "f", deleted: true},
+		{name: "вызывается другой функцией", fnName: "g", wantErr: ErrFunctionInUse},
+		{
+			name:    "используется сохранённым вычислением",
+			fnName:  "h",
+			calcs:   []calculationService.Calculation{{ID: "1", Functions: calculationService.Bindings{"h": "h() = 42"}}},
+			wantErr: ErrFunctionInUse,
+		},
+		{name: "нет такой функции", fnName: "k", wantErr: ErrFunctionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockFunctionRepository)
+			mockCalcs := new(MockCalculationLister)
+			mockRepo.On("GetFunctionsForUser", mock.Anything, "alice").Return(funcs, nil)
+			mockCalcs.On("GetAllCalculationsForUser", mock.Anything, "alice").Return(tt.calcs, nil).Maybe()
+			if tt.deleted {
+				mockRepo.On("DeleteFunction", mock.Anything, "alice", tt.fnName).Return(nil)
+			}
+
+			service := NewFunctionService(mockRepo, mockCalcs)
+			err := service.DeleteFunction(t.Context(), "alice", tt.fnName)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	"CalculatorAppFrontendPantela-main/internal/web/auth"
+)
+
+// AuthHandler — структура, адаптирующая AuthService для auth API
+type AuthHandler struct {
+	service authService.AuthService
+}
+
+// NewAuthHandler — конструктор для создания нового auth хендлера
+func NewAuthHandler(s authService.AuthService) *AuthHandler {
+	return &AuthHandler{service: s}
+}
+
+// PostAuthLogin - вход по email и паролю
+func (h *AuthHandler) PostAuthLogin(ctx context.Context, request auth.PostAuthLoginRequestObject) (auth.PostAuthLoginResponseObject, error) {
+	pair, err := h.service.Login(ctx, string(request.Body.Email), request.Body.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.PostAuthLogin200JSONResponse(toAPITokenPair(pair)), nil
+}
+
+// PostAuthRefresh - обмен refresh-токена на новую пару токенов
+func (h *AuthHandler) PostAuthRefresh(ctx context.Context, request auth.PostAuthRefreshRequestObject) (auth.PostAuthRefreshResponseObject, error) {
+	pair, err := h.service.Refresh(ctx, request.Body.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.PostAuthRefresh200JSONResponse(toAPITokenPair(pair)), nil
+}
+
+// PostAuthLogout - отзыв refresh-токена
+func (h *AuthHandler) PostAuthLogout(ctx context.Context, request auth.PostAuthLogoutRequestObject) (auth.PostAuthLogoutResponseObject, error) {
+	err := h.service.Logout(ctx, request.Body.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.PostAuthLogout204Response{}, nil
+}
+
+// toAPITokenPair — конвертирует пару токенов в ответ API
+func toAPITokenPair(pair authService.TokenPair) auth.TokenPair {
+	return auth.TokenPair{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(pair.ExpiresIn.Seconds()),
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+// publicRoutes — маршруты, доступные без access-токена (метод + шаблон пути Echo).
+var publicRoutes = map[string]bool{
+	http.MethodPost + " /auth/login":   true,
+	http.MethodPost + " /auth/refresh": true,
+	http.MethodPost + " /auth/logout":  true,
+	http.MethodPost + " /users":        true,
+}
+
+// JWTAuth — middleware, проверяющая заголовок "Authorization: Bearer <token>".
+// Пользователь из токена кладётся в context.Context запроса,
+// откуда его читают strict-хендлеры через authService.IdentityFromContext.
+func JWTAuth(auth authService.AuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if publicRoutes[c.Request().Method+" "+c.Path()] {
+				return next(c)
+			}
+
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || token == "" {
+				return unauthorized(c, "missing bearer token")
+			}
+
+			identity, err := auth.ParseAccessToken(token)
+			if err != nil {
+				return unauthorized(c, "invalid or expired token")
+			}
+
+			ctx := authService.WithIdentity(c.Request().Context(), identity)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+func unauthorized(c echo.Context, message string) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="api"`)
+	return echo.NewHTTPError(http.StatusUnauthorized, message)
+}
+
+// currentUser — пользователь текущего запроса; без него обработчик отвечает 401.
+func currentUser(ctx context.Context) (authService.Identity, error) {
+	identity, ok := authService.IdentityFromContext(ctx)
+	if !ok {
+		return authService.Identity{}, echo.NewHTTPError(http.StatusUnauthorized, "authentication required")
+	}
+	return identity, nil
+}
+
+// requester — пользователь текущего запроса в терминах CalculationService.
+func requester(ctx context.Context) (calculationService.Requester, error) {
+	identity, err := currentUser(ctx)
+	if err != nil {
+		return calculationService.Requester{}, err
+	}
+	return calculationService.Requester{UserID: identity.UserID, Admin: identity.IsAdmin}, nil
+}
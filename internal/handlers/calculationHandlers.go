@@ -22,9 +22,15 @@ func NewCalculationHandler(s calculationService.CalculationService) *Calculation
 // GET /calculations
 // ---------------------------
 func (h *CalculationHandler) GetCalculations(c echo.Context) error {
-	calculations, err := h.service.GetAllCalculations()
+	ctx := c.Request().Context()
+	r, err := requester(ctx)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Could not get calculations"})
+		return err
+	}
+
+	calculations, err := h.service.GetAllCalculationsForUser(ctx, r)
+	if err != nil {
+		return err
 	}
 	return c.JSON(http.StatusOK, calculations)
 }
@@ -37,14 +43,19 @@ func (h *CalculationHandler) PostCalculations(c echo.Context) error {
 
 	// Привязка данных из JSON
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	ctx := c.Request().Context()
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
 	}
 
-	// Создание новой записи через сервис
-	// TODO: Add support for userID when needed
-	calc, err := h.service.CreateCalculation(req.Expression, "")
+	// Создание новой записи через сервис от имени текущего пользователя
+	calc, err := h.service.CreateCalculation(ctx, req.Expression, user.UserID, evalOptionsFromRequest(req))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Could not create calculation"})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, calc)
@@ -58,12 +69,18 @@ func (h *CalculationHandler) PatchCalculations(c echo.Context) error {
 
 	var req calculationService.CalculationRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
-	updatedCalc, err := h.service.UpdateCalculation(id, req.Expression)
+	ctx := c.Request().Context()
+	r, err := requester(ctx)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Could not update calculation"})
+		return err
+	}
+
+	updatedCalc, err := h.service.UpdateCalculationForUser(ctx, id, req.Expression, evalOptionsFromRequest(req), r)
+	if err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, updatedCalc)
@@ -75,9 +92,21 @@ func (h *CalculationHandler) PatchCalculations(c echo.Context) error {
 func (h *CalculationHandler) DeleteCalculations(c echo.Context) error {
 	id := c.Param("id")
 
-	if err := h.service.DeleteCalculation(id); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Could not delete calculation"})
+	ctx := c.Request().Context()
+	r, err := requester(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = h.service.DeleteCalculationForUser(ctx, id, r)
+	if err != nil {
+		return err
 	}
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+// evalOptionsFromRequest — параметры вычисления из тела запроса /calculations
+func evalOptionsFromRequest(req calculationService.CalculationRequest) calculationService.EvalOptions {
+	return calculationService.EvalOptions{Engine: req.Engine, Mode: req.Mode, Precision: req.Precision, WordType: req.WordType, ComplexForm: req.ComplexForm, AngleUnit: req.AngleUnit}
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	functionService "CalculatorAppFrontendPantela-main/internal/functionService"
+	"CalculatorAppFrontendPantela-main/internal/web/functions"
+)
+
+// FunctionHandler — справочник функций и функции пользователя
+type FunctionHandler struct {
+	service functionService.FunctionService
+}
+
+// NewFunctionHandler — конструктор для создания нового function хендлера
+func NewFunctionHandler(s functionService.FunctionService) *FunctionHandler {
+	return &FunctionHandler{service: s}
+}
+
+// GetFunctions - реализация получения списка встроенных функций и функций пользователя
+func (h *FunctionHandler) GetFunctions(ctx context.Context, request functions.GetFunctionsRequestObject) (functions.GetFunctionsResponseObject, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	own, err := h.service.ListFunctions(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	builtins := calculationService.Functions()
+	result := make([]functions.FunctionInfo, 0, len(builtins)+len(own))
+	for _, f := range builtins {
+		result = append(result, toAPIFunction(f))
+	}
+	for _, f := range own {
+		def := f.Definition()
+		definition := def.String()
+		arity := len(def.Params)
+		result = append(result, functions.FunctionInfo{
+			Name:        f.Name,
+			Category:    functions.FunctionInfoCategory(calculationService.CategoryUser),
+			MinArgs:     arity,
+			MaxArgs:     &arity,
+			Description: f.Description,
+			Modes:       []string{calculationService.ModeFloat, calculationService.ModeUnits, calculationService.ModeRational, calculationService.ModeDecimal},
+			Definition:  &definition,
+		})
+	}
+	return functions.GetFunctions200JSONResponse(result), nil
+}
+
+// PostFunctions - реализация создания функции
+func (h *FunctionHandler) PostFunctions(ctx context.Context, request functions.PostFunctionsRequestObject) (functions.PostFunctionsResponseObject, error) {
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
+	}
+
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var description string
+	if request.Body.Description != nil {
+		description = *request.Body.Description
+	}
+
+	f, err := h.service.CreateFunction(ctx, user.UserID, request.Body.Name, request.Body.Params, request.Body.Body, description)
+	if err != nil {
+		return nil, err
+	}
+
+	return functions.PostFunctions201JSONResponse(toAPIUserFunction(f)), nil
+}
+
+// GetFunctionsName - реализация получения функции пользователя по имени
+func (h *FunctionHandler) GetFunctionsName(ctx context.Context, request functions.GetFunctionsNameRequestObject) (functions.GetFunctionsNameResponseObject, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := h.service.GetFunction(ctx, user.UserID, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return functions.GetFunctionsName200JSONResponse(toAPIUserFunction(f)), nil
+}
+
+// PatchFunctionsName - реализация изменения функции пользователя
+func (h *FunctionHandler) PatchFunctionsName(ctx context.Context, request functions.PatchFunctionsNameRequestObject) (functions.PatchFunctionsNameResponseObject, error) {
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
+	}
+
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var params *[]string
+	if request.Body.Params != nil {
+		params = &request.Body.Params
+	}
+
+	f, err := h.service.UpdateFunction(ctx, user.UserID, request.Name, params, request.Body.Body, request.Body.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return functions.PatchFunctionsName200JSONResponse(toAPIUserFunction(f)), nil
+}
+
+// DeleteFunctionsName - реализация удаления функции пользователя
+func (h *FunctionHandler) DeleteFunctionsName(ctx context.Context, request functions.DeleteFunctionsNameRequestObject) (functions.DeleteFunctionsNameResponseObject, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.DeleteFunction(ctx, user.UserID, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return functions.DeleteFunctionsName204Response{}, nil
+}
+
+// toAPIFunction — конвертирует описание функции в ответ API
+func toAPIFunction(f calculationService.FunctionInfo) functions.FunctionInfo {
+	info := functions.FunctionInfo{
+		Name:        f.Name,
+		Category:    functions.FunctionInfoCategory(f.Category),
+		MinArgs:     f.MinArgs,
+		Description: f.Description,
+		Modes:       f.Modes,
+	}
+	if f.MaxArgs >= 0 {
+		maxArgs := f.MaxArgs
+		info.MaxArgs = &maxArgs
+	}
+	return info
+}
+
+// toAPIUserFunction — конвертирует UserFunction в ответ API
+func toAPIUserFunction(f functionService.UserFunction) functions.UserFunction {
+	definition := f.Definition().String()
+	return functions.UserFunction{
+		Name:        f.Name,
+		Params:      f.ParamList(),
+		Body:        f.Body,
+		Description: &f.Description,
+		Definition:  &definition,
+		CreatedAt:   &f.CreatedAt,
+		UpdatedAt:   &f.UpdatedAt,
+	}
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	currencyService "CalculatorAppFrontendPantela-main/internal/currencyService"
+	functionService "CalculatorAppFrontendPantela-main/internal/functionService"
+	userService "CalculatorAppFrontendPantela-main/internal/userService"
+	variableService "CalculatorAppFrontendPantela-main/internal/variableService"
+)
+
+// ProblemContentType — тип содержимого ответов об ошибках (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// Problem — тело ответа об ошибке по RFC 7807, схема Problem в openapi.yaml.
+// Code — машиночитаемый код; Offset и Token указывают место ошибки в выражении.
+type Problem struct {
+	Type     string  `json:"type"`
+	Title    string  `json:"title"`
+	Status   int     `json:"status"`
+	Detail   string  `json:"detail,omitempty"`
+	Instance string  `json:"instance,omitempty"`
+	Code     string  `json:"code"`
+	Offset   *int    `json:"offset,omitempty"`
+	Token    *string `json:"token,omitempty"`
+}
+
+// problemKind — статус и код для ошибки сервиса.
+type problemKind struct {
+	err    error
+	status int
+	code   string
+}
+
+// problemKinds — ошибки сервисов и их представление в API. Порядок важен:
+// берётся первая ошибка, с которой совпадает цепочка errors.Is.
+var problemKinds = []problemKind{
+	// 400: выражение или запрос некорректны.
+	{calculationService.ErrUnknownVariable, http.StatusBadRequest, "unknown_variable"},
+	{calculationService.ErrUnknownFunction, http.StatusBadRequest, "unknown_function"},
+	{calculationService.ErrUnknownUnit, http.StatusBadRequest, "unknown_unit"},
+	{calculationService.ErrArity, http.StatusBadRequest, "wrong_argument_count"},
+	{calculationService.ErrUnknownEngine, http.StatusBadRequest, "unknown_engine"},
+	{calculationService.ErrUnsupportedMode, http.StatusBadRequest, "unsupported_mode"},
+	{calculationService.ErrInvalidPrecision, http.StatusBadRequest, "invalid_precision"},
+	{calculationService.ErrInvalidWordType, http.StatusBadRequest, "invalid_word_type"},
+	{calculationService.ErrInvalidComplexForm, http.StatusBadRequest, "invalid_complex_form"},
+	{calculationService.ErrInvalidAngleUnit, http.StatusBadRequest, "invalid_angle_unit"},
+	{calculationService.ErrInvalidID, http.StatusBadRequest, "invalid_id"},
+	{calculationService.ErrInvalidCursor, http.StatusBadRequest, "invalid_cursor"},
+	{calculationService.ErrInvalidListOptions, http.StatusBadRequest, "invalid_request"},
+	{calculationService.ErrRecursion, http.StatusBadRequest, "recursive_function"},
+	{calculationService.ErrInvalidDefinition, http.StatusBadRequest, "invalid_function"},
+	{variableService.ErrInvalidName, http.StatusBadRequest, "invalid_variable_name"},
+	{variableService.ErrReservedName, http.StatusBadRequest, "reserved_variable_name"},
+	{variableService.ErrInvalidValue, http.StatusBadRequest, "invalid_variable_value"},
+	{currencyService.ErrInvalidRate, http.StatusBadRequest, "invalid_rate"},
+	{calculationService.ErrExpressionTooLong, http.StatusBadRequest, calculationService.CodeExpressionTooLong},
+	{calculationService.ErrTooManyTokens, http.StatusBadRequest, calculationService.CodeTooManyTokens},
+	{calculationService.ErrExpressionTooDeep, http.StatusBadRequest, calculationService.CodeExpressionTooDeep},
+
+	// 401, 403, 404.
+	{userService.ErrInvalidCredentials, http.StatusUnauthorized, "invalid_credentials"},
+	{authService.ErrInvalidToken, http.StatusUnauthorized, "invalid_token"},
+	{calculationService.ErrForbidden, http.StatusForbidden, "forbidden"},
+	{calculationService.ErrCalculationNotFound, http.StatusNotFound, "not_found"},
+	{calculationService.ErrRevisionNotFound, http.StatusNotFound, "not_found"},
+	{variableService.ErrVariableNotFound, http.StatusNotFound, "not_found"},
+	{functionService.ErrFunctionNotFound, http.StatusNotFound, "not_found"},
+	{userService.ErrUserNotFound, http.StatusNotFound, "not_found"},
+
+	// 409: конфликт с текущим состоянием.
+	{variableService.ErrVariableExists, http.StatusConflict, "already_exists"},
+	{functionService.ErrFunctionExists, http.StatusConflict, "already_exists"},
+	{functionService.ErrFunctionInUse, http.StatusConflict, "function_in_use"},
+	// Нарушение уникальности в хранилище, например занятый email.
+	{gorm.ErrDuplicatedKey, http.StatusConflict, "already_exists"},
+
+	// 412: запись изменили после того, как клиент её прочитал.
+	{calculationService.ErrVersionMismatch, http.StatusPreconditionFailed, "precondition_failed"},
+
+	// 422: выражение корректно, но посчитать его нельзя.
+	{calculationService.ErrDivisionByZero, http.StatusUnprocessableEntity, "division_by_zero"},
+	{calculationService.ErrOverflow, http.StatusUnprocessableEntity, "overflow"},
+	{calculationService.ErrDomain, http.StatusUnprocessableEntity, "domain_error"},
+	{calculationService.ErrNotExact, http.StatusUnprocessableEntity, "not_exact"},
+	{calculationService.ErrDimensionMismatch, http.StatusUnprocessableEntity, "dimension_mismatch"},
+	{calculationService.ErrTimeout, http.StatusUnprocessableEntity, calculationService.CodeTimeout},
+	{calculationService.ErrResultTooLarge, http.StatusUnprocessableEntity, calculationService.CodeResultTooLarge},
+
+	// 503: хранилище не ответило за отведённое запросу время (DBTimeout).
+	{context.DeadlineExceeded, http.StatusServiceUnavailable, "timeout"},
+}
+
+// httpCodes — коды для ошибок echo.HTTPError (привязка тела, авторизация).
+var httpCodes = map[int]string{
+	http.StatusBadRequest:            "invalid_request",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not_found",
+	http.StatusMethodNotAllowed:      "method_not_allowed",
+	http.StatusConflict:              "conflict",
+	http.StatusRequestEntityTooLarge: "request_too_large",
+	http.StatusUnsupportedMediaType:  "unsupported_media_type",
+	http.StatusUnprocessableEntity:   "unprocessable",
+	http.StatusTooManyRequests:       "too_many_requests",
+}
+
+// NewProblem — Problem для ошибки любого слоя. Неизвестные ошибки дают 500
+// без подробностей: их текст может раскрыть устройство сервера.
+func NewProblem(err error) Problem {
+	var syntaxErr *calculationService.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		p := problem(http.StatusBadRequest, "syntax_error", err.Error())
+		if syntaxErr.Offset >= 0 {
+			p.Offset = &syntaxErr.Offset
+			p.Token = &syntaxErr.Token
+		}
+		return p
+	}
+
+	for _, kind := range problemKinds {
+		if errors.Is(err, kind.err) {
+			p := problem(kind.status, kind.code, err.Error())
+			var nameErr *calculationService.NameError
+			if errors.As(err, &nameErr) && nameErr.Offset >= 0 {
+				p.Offset = &nameErr.Offset
+				p.Token = &nameErr.Name
+			}
+			return p
+		}
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		code, ok := httpCodes[httpErr.Code]
+		if !ok {
+			code = "http_error"
+		}
+		detail := fmt.Sprint(httpErr.Message)
+		if httpErr.Code >= http.StatusInternalServerError {
+			detail = ""
+		}
+		return problem(httpErr.Code, code, detail)
+	}
+
+	return problem(http.StatusInternalServerError, "internal_error", "")
+}
+
+// problem — Problem с типом вида /problems/<code> и стандартным заголовком статуса.
+func problem(status int, code, detail string) Problem {
+	return Problem{
+		Type:   "/problems/" + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// ErrorHandler — обработчик ошибок Echo: любую ошибку хендлера или
+// middleware отдаёт как application/problem+json.
+func ErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	p := NewProblem(err)
+	p.Instance = c.Request().URL.Path
+	if p.Status == http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, ProblemContentType)
+	c.Response().WriteHeader(p.Status)
+	if c.Request().Method == http.MethodHead {
+		return
+	}
+	if encodeErr := json.NewEncoder(c.Response()).Encode(p); encodeErr != nil {
+		c.Logger().Error(encodeErr)
+	}
+}
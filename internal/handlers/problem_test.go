@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	currencyService "CalculatorAppFrontendPantela-main/internal/currencyService"
+	functionService "CalculatorAppFrontendPantela-main/internal/functionService"
+)
+
+func TestNewProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+		offset *int
+		token  string
+	}{
+		{"синтаксис", &calculationService.SyntaxError{Offset: 4, Token: ")", Message: "unexpected \")\""}, http.StatusBadRequest, "syntax_error", intPtr(4), ")"},
+		{"синтаксис без позиции", &calculationService.SyntaxError{Offset: -1, Message: "bad"}, http.StatusBadRequest, "syntax_error", nil, ""},
+		{"неизвестная функция", &calculationService.NameError{Err: calculationService.ErrUnknownFunction, Name: "foo", Offset: 2}, http.StatusBadRequest, "unknown_function", intPtr(2), "foo"},
+		{"неизвестная единица", &calculationService.NameError{Err: calculationService.ErrUnknownUnit, Name: "parsec", Offset: 8}, http.StatusBadRequest, "unknown_unit", intPtr(8), "parsec"},
+		{"размерности", fmt.Errorf("%w: m + s", calculationService.ErrDimensionMismatch), http.StatusUnprocessableEntity, "dimension_mismatch", nil, ""},
+		{"тип слова", fmt.Errorf("%w: \"int128\"", calculationService.ErrInvalidWordType), http.StatusBadRequest, "invalid_word_type", nil, ""},
+		{"запись комплексного числа", fmt.Errorf("%w: \"exp\"", calculationService.ErrInvalidComplexForm), http.StatusBadRequest, "invalid_complex_form", nil, ""},
+		{"неверный курс", fmt.Errorf("%w: record 2", currencyService.ErrInvalidRate), http.StatusBadRequest, "invalid_rate", nil, ""},
+		{"деление на ноль", fmt.Errorf("eval: %w", calculationService.ErrDivisionByZero), http.StatusUnprocessableEntity, "division_by_zero", nil, ""},
+		{"переполнение", calculationService.ErrOverflow, http.StatusUnprocessableEntity, "overflow", nil, ""},
+		{"не найдено", calculationService.ErrCalculationNotFound, http.StatusNotFound, "not_found", nil, ""},
+		{"запрещено", calculationService.ErrForbidden, http.StatusForbidden, "forbidden", nil, ""},
+		{"функция используется", functionService.ErrFunctionInUse, http.StatusConflict, "function_in_use", nil, ""},
+		{"email занят", gorm.ErrDuplicatedKey, http.StatusConflict, "already_exists", nil, ""},
+		{"таймаут", &calculationService.LimitError{Code: calculationService.CodeTimeout, Message: "slow"}, http.StatusUnprocessableEntity, calculationService.CodeTimeout, nil, ""},
+		{"таймаут БД", fmt.Errorf("query: %w", context.DeadlineExceeded), http.StatusServiceUnavailable, "timeout", nil, ""},
+		{"ошибка echo", echo.NewHTTPError(http.StatusBadRequest, "task is required"), http.StatusBadRequest, "invalid_request", nil, ""},
+		{"неизвестная ошибка", errors.New("db is down"), http.StatusInternalServerError, "internal_error", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProblem(tt.err)
+			assert.Equal(t, tt.status, p.Status)
+			assert.Equal(t, tt.code, p.Code)
+			assert.Equal(t, "/problems/"+tt.code, p.Type)
+			assert.Equal(t, tt.offset, p.Offset)
+			if tt.token != "" {
+				require.NotNil(t, p.Token)
+				assert.Equal(t, tt.token, *p.Token)
+			}
+			if tt.status == http.StatusInternalServerError {
+				assert.Empty(t, p.Detail)
+			}
+		})
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tasks/42", nil)
+	rec := httptest.NewRecorder()
+
+	ErrorHandler(calculationService.ErrCalculationNotFound, e.NewContext(req, rec))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, ProblemContentType, rec.Header().Get(echo.HeaderContentType))
+	var p Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	assert.Equal(t, "not_found", p.Code)
+	assert.Equal(t, "/tasks/42", p.Instance)
+}
+
+func intPtr(v int) *int { return &v }
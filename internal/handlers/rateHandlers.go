@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	currencyService "CalculatorAppFrontendPantela-main/internal/currencyService"
+	"CalculatorAppFrontendPantela-main/internal/web/rates"
+)
+
+// RateHandler — структура, адаптирующая CurrencyService для rates API
+type RateHandler struct {
+	service currencyService.CurrencyService
+}
+
+// NewRateHandler — конструктор для создания нового rate хендлера
+func NewRateHandler(s currencyService.CurrencyService) *RateHandler {
+	return &RateHandler{service: s}
+}
+
+// GetRates - реализация получения курсов на дату (по умолчанию — на сегодня)
+func (h *RateHandler) GetRates(ctx context.Context, request rates.GetRatesRequestObject) (rates.GetRatesResponseObject, error) {
+	at := time.Now().UTC()
+	if request.Params.Date != nil {
+		at = request.Params.Date.Time
+	}
+
+	values, err := h.service.RatesAt(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return rates.GetRates200JSONResponse{
+		Base:  h.service.Base(),
+		Date:  openapi_types.Date{Time: at.Truncate(24 * time.Hour)},
+		Rates: values,
+	}, nil
+}
+
+// PostRatesImport - реализация импорта курсов из CSV или JSON (только для администратора)
+func (h *RateHandler) PostRatesImport(ctx context.Context, request rates.PostRatesImportRequestObject) (rates.PostRatesImportResponseObject, error) {
+	identity, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !identity.IsAdmin {
+		return nil, calculationService.ErrForbidden
+	}
+
+	var imported int
+	switch {
+	case request.JSONBody != nil:
+		records := make([]currencyService.ExchangeRate, 0, len(request.JSONBody.Rates))
+		for _, r := range request.JSONBody.Rates {
+			records = append(records, currencyService.ExchangeRate{
+				Currency:      r.Currency,
+				EffectiveDate: r.Date.Format(time.DateOnly),
+				Rate:          r.Rate,
+			})
+		}
+		imported, err = h.service.ImportRates(ctx, records)
+	case request.Body != nil:
+		imported, err = h.service.ImportCSV(ctx, request.Body)
+	default:
+		return nil, echo.NewHTTPError(http.StatusUnsupportedMediaType, "request body must be text/csv or application/json")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rates.PostRatesImport200JSONResponse{Imported: imported, Base: h.service.Base()}, nil
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	currencyService "CalculatorAppFrontendPantela-main/internal/currencyService"
+	"CalculatorAppFrontendPantela-main/internal/web/rates"
+)
+
+func TestPostRatesImport(t *testing.T) {
+	tests := []struct {
+		name        string
+		admin       bool
+		contentType string
+		body        string
+		status      int
+		response    string // ожидаемое тело (JSON) или код ошибки
+	}{
+		{
+			name: "CSV", admin: true, contentType: "text/csv",
+			body:   "date,currency,rate\n2026-10-01,EUR,0.92\n2026-10-01,GBP,0.79\n",
+			status: http.StatusOK, response: `{"imported": 2, "base": "USD"}`,
+		},
+		{
+			name: "JSON", admin: true, contentType: echo.MIMEApplicationJSON,
+			body:   `{"rates": [{"date": "2026-10-01", "currency": "EUR", "rate": "0.92"}]}`,
+			status: http.StatusOK, response: `{"imported": 1, "base": "USD"}`,
+		},
+		{
+			name: "неверный курс в CSV", admin: true, contentType: "text/csv",
+			body:   "date,currency,rate\n2026-10-01,EUR,-1\n",
+			status: http.StatusBadRequest, response: "invalid_rate",
+		},
+		{
+			name: "JSON не по схеме", admin: true, contentType: echo.MIMEApplicationJSON,
+			body:   `{"rates": [{"date": "2026-10-01", "currency": "euro", "rate": "0.92"}]}`,
+			status: http.StatusBadRequest, response: "invalid_request",
+		},
+		{
+			name: "не администратор", contentType: "text/csv",
+			body:   "date,currency,rate\n2026-10-01,EUR,0.92\n",
+			status: http.StatusForbidden, response: "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := rates.GetSwagger()
+			require.NoError(t, err)
+			validator, err := OpenAPIValidator(ValidatorConfig{Specs: []*openapi3.T{spec}})
+			require.NoError(t, err)
+
+			repo := new(currencyService.MockRateRepository)
+			repo.On("SaveRates", mock.Anything, mock.Anything).Return(nil).Maybe()
+			handler := NewRateHandler(currencyService.NewCurrencyService(repo, ""))
+
+			e := echo.New()
+			e.HTTPErrorHandler = ErrorHandler
+			e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					ctx := authService.WithIdentity(c.Request().Context(), authService.Identity{UserID: "root", IsAdmin: tt.admin})
+					c.SetRequest(c.Request().WithContext(ctx))
+					return next(c)
+				}
+			})
+			e.Use(validator)
+			rates.RegisterHandlers(e, rates.NewStrictHandler(handler, nil))
+
+			req := httptest.NewRequest(http.MethodPost, "/rates/import", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+			if tt.status == http.StatusOK {
+				assert.JSONEq(t, tt.response, rec.Body.String())
+			} else {
+				assert.Contains(t, rec.Body.String(), `"code":"`+tt.response+`"`)
+			}
+		})
+	}
+}
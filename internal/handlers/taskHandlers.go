@@ -2,7 +2,12 @@ package handlers
 
 import (
 	"context"
-	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
 
 	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
 	"CalculatorAppFrontendPantela-main/internal/web/tasks"
@@ -18,85 +23,473 @@ func NewTaskHandler(s calculationService.CalculationService) *TaskHandler {
 	return &TaskHandler{service: s}
 }
 
-// GetTasks - реализация получения всех задач (вычислений)
+// GetTasks - реализация получения страницы задач (вычислений)
 func (h *TaskHandler) GetTasks(ctx context.Context, request tasks.GetTasksRequestObject) (tasks.GetTasksResponseObject, error) {
-	calculations, err := h.service.GetAllCalculations()
+	r, err := requester(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Конвертируем Calculation в Task
-	result := make([]tasks.Task, 0, len(calculations))
-	for _, calc := range calculations {
-		isDone := calc.Result != ""
-		task := tasks.Task{
-			Id:     nil, // можно добавить парсинг ID если нужно
-			IsDone: &isDone,
-			Task:   &calc.Expression,
-			Result: &calc.Result,
-		}
-		result = append(result, task)
+	page, err := h.service.ListCalculationsForUser(ctx, r, listOptions(request.Params))
+	if err != nil {
+		return nil, err
 	}
 
-	return tasks.GetTasks200JSONResponse(result), nil
+	return tasks.GetTasks200JSONResponse(toAPITaskPage(page)), nil
+}
+
+// GetTasksTrash - реализация получения страницы удалённых задач
+func (h *TaskHandler) GetTasksTrash(ctx context.Context, request tasks.GetTasksTrashRequestObject) (tasks.GetTasksTrashResponseObject, error) {
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts calculationService.ListOptions
+	if request.Params.Limit != nil {
+		opts.Limit = *request.Params.Limit
+	}
+	if request.Params.Cursor != nil {
+		opts.Cursor = *request.Params.Cursor
+	}
+
+	page, err := h.service.ListTrashForUser(ctx, r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.GetTasksTrash200JSONResponse(toAPITaskPage(page)), nil
 }
 
 // PostTasks - реализация создания новой задачи (вычисления)
 func (h *TaskHandler) PostTasks(ctx context.Context, request tasks.PostTasksRequestObject) (tasks.PostTasksResponseObject, error) {
-	if request.Body == nil || request.Body.Task == nil {
-		return nil, nil
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
 	}
 
-	// TODO: Extract user_id from request body when frontend supports it
-	calc, err := h.service.CreateCalculation(*request.Body.Task, "")
+	user, err := currentUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	isDone := calc.Result != ""
-	result := tasks.Task{
-		Id:     nil,
-		IsDone: &isDone,
-		Task:   &calc.Expression,
-		Result: &calc.Result,
+	calc, err := h.service.CreateCalculation(ctx, request.Body.Task, user.UserID, evalOptions(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.PostTasks201JSONResponse(toAPITask(calc)), nil
+}
+
+// GetTasksId - реализация получения одной задачи по ID
+func (h *TaskHandler) GetTasksId(ctx context.Context, request tasks.GetTasksIdRequestObject) (tasks.GetTasksIdResponseObject, error) {
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return tasks.PostTasks201JSONResponse(result), nil
+	calc, err := h.service.GetCalculationByIDForUser(ctx, id, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.GetTasksId200JSONResponse{Body: toAPITask(calc), Headers: tasks.GetTasksId200ResponseHeaders{ETag: etag(calc)}}, nil
 }
 
 // PatchTasksId - реализация обновления задачи (вычисления)
 func (h *TaskHandler) PatchTasksId(ctx context.Context, request tasks.PatchTasksIdRequestObject) (tasks.PatchTasksIdResponseObject, error) {
-	if request.Body == nil || request.Body.Task == nil {
-		return nil, nil
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
 	}
 
-	// Конвертируем uint в string для ID
-	idStr := fmt.Sprintf("%d", request.Id)
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	calc, err := h.service.UpdateCalculation(idStr, *request.Body.Task)
+	r, err := requester(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	isDone := calc.Result != ""
-	result := tasks.Task{
-		Id:     &request.Id,
-		IsDone: &isDone,
-		Task:   &calc.Expression,
-		Result: &calc.Result,
+	version, err := h.precondition(ctx, id, request.Params.IfMatch, r)
+	if err != nil {
+		return nil, err
 	}
 
-	return tasks.PatchTasksId200JSONResponse(result), nil
+	var calc calculationService.Calculation
+	if version == 0 {
+		calc, err = h.service.UpdateCalculationForUser(ctx, id, request.Body.Task, evalOptions(*request.Body), r)
+	} else {
+		calc, err = h.service.UpdateCalculationIfVersion(ctx, id, request.Body.Task, evalOptions(*request.Body), version, r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.PatchTasksId200JSONResponse{Body: toAPITask(calc), Headers: tasks.PatchTasksId200ResponseHeaders{ETag: etag(calc)}}, nil
 }
 
 // DeleteTasksId - реализация удаления задачи
 func (h *TaskHandler) DeleteTasksId(ctx context.Context, request tasks.DeleteTasksIdRequestObject) (tasks.DeleteTasksIdResponseObject, error) {
-	// Конвертируем uint в string для ID
-	idStr := fmt.Sprintf("%d", request.Id)
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := h.service.DeleteCalculation(idStr); err != nil {
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := h.precondition(ctx, id, request.Params.IfMatch, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == 0 {
+		err = h.service.DeleteCalculationForUser(ctx, id, r)
+	} else {
+		err = h.service.DeleteCalculationIfVersion(ctx, id, version, r)
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	return tasks.DeleteTasksId204Response{}, nil
 }
+
+// PostTasksIdRestore - реализация восстановления задачи из корзины
+func (h *TaskHandler) PostTasksIdRestore(ctx context.Context, request tasks.PostTasksIdRestoreRequestObject) (tasks.PostTasksIdRestoreResponseObject, error) {
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calc, err := h.service.RestoreCalculationForUser(ctx, id, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.PostTasksIdRestore200JSONResponse(toAPITask(calc)), nil
+}
+
+// GetTasksIdRevisions - реализация получения истории ревизий задачи
+func (h *TaskHandler) GetTasksIdRevisions(ctx context.Context, request tasks.GetTasksIdRevisionsRequestObject) (tasks.GetTasksIdRevisionsResponseObject, error) {
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := h.service.GetRevisionsForUser(ctx, id, r)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(tasks.GetTasksIdRevisions200JSONResponse, 0, len(revisions))
+	for _, rev := range revisions {
+		response = append(response, toAPIRevision(rev))
+	}
+	return response, nil
+}
+
+// GetTasksIdRevisionsDiff - реализация сравнения двух ревизий задачи
+func (h *TaskHandler) GetTasksIdRevisionsDiff(ctx context.Context, request tasks.GetTasksIdRevisionsDiffRequestObject) (tasks.GetTasksIdRevisionsDiffResponseObject, error) {
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := h.service.DiffRevisionsForUser(ctx, id, request.Params.From, request.Params.To, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.GetTasksIdRevisionsDiff200JSONResponse(toAPIRevisionDiff(diff)), nil
+}
+
+// PostTasksIdRevertRev - реализация отката задачи к ревизии
+func (h *TaskHandler) PostTasksIdRevertRev(ctx context.Context, request tasks.PostTasksIdRevertRevRequestObject) (tasks.PostTasksIdRevertRevResponseObject, error) {
+	id, err := taskID(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := requester(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calc, err := h.service.RevertCalculationForUser(ctx, id, request.Rev, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.PostTasksIdRevertRev200JSONResponse(toAPITask(calc)), nil
+}
+
+// taskID — проверяет ID из пути: UUID или числовой ID старой схемы
+func taskID(raw tasks.TaskId) (string, error) {
+	return calculationService.NormalizeID(raw)
+}
+
+// etag — ETag задачи: её версия в кавычках
+func etag(calc calculationService.Calculation) string {
+	return strconv.Quote(strconv.Itoa(calc.Version))
+}
+
+// precondition — версия задачи, которую требует заголовок If-Match; 0 —
+// подходит любая (заголовка нет или он "*"). Если в заголовке несколько
+// ETag, выбирается совпадающий с текущей версией задачи. Слабые ETag (W/)
+// при сравнении не совпадают никогда.
+func (h *TaskHandler) precondition(ctx context.Context, id string, header *string, r calculationService.Requester) (int, error) {
+	if header == nil || strings.TrimSpace(*header) == "*" {
+		return 0, nil
+	}
+
+	var versions []int
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		raw, err := strconv.Unquote(tag)
+		if err != nil {
+			continue
+		}
+		if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+			versions = append(versions, v)
+		}
+	}
+	switch len(versions) {
+	case 0:
+		return 0, calculationService.ErrVersionMismatch
+	case 1:
+		return versions[0], nil
+	}
+
+	calc, err := h.service.GetCalculationByIDForUser(ctx, id, r)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains(versions, calc.Version) {
+		return calc.Version, nil
+	}
+	return 0, calculationService.ErrVersionMismatch
+}
+
+// listOptions — параметры страницы истории из query-параметров GET /tasks
+func listOptions(params tasks.GetTasksParams) calculationService.ListOptions {
+	var opts calculationService.ListOptions
+	if params.Limit != nil {
+		opts.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		opts.Cursor = *params.Cursor
+	}
+	if params.Sort != nil {
+		opts.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		opts.Order = string(*params.Order)
+	}
+	if params.Q != nil {
+		opts.Filter.Expression = *params.Q
+	}
+	if params.Status != nil {
+		opts.Filter.Status = string(*params.Status)
+	}
+	opts.Filter.ResultMin = params.ResultMin
+	opts.Filter.ResultMax = params.ResultMax
+	opts.Filter.From = params.CreatedFrom
+	opts.Filter.To = params.CreatedTo
+	return opts
+}
+
+// evalOptions — параметры вычисления, переданные в теле задачи
+func evalOptions(task tasks.Task) calculationService.EvalOptions {
+	var opts calculationService.EvalOptions
+	if task.Engine != nil {
+		opts.Engine = *task.Engine
+	}
+	if task.Mode != nil {
+		opts.Mode = string(*task.Mode)
+	}
+	if task.Precision != nil {
+		opts.Precision = *task.Precision
+	}
+	if task.WordType != nil {
+		opts.WordType = string(*task.WordType)
+	}
+	if task.ComplexForm != nil {
+		opts.ComplexForm = string(*task.ComplexForm)
+	}
+	if task.AngleUnit != nil {
+		opts.AngleUnit = string(*task.AngleUnit)
+	}
+	return opts
+}
+
+// toAPITaskPage — конвертирует страницу Calculation в TaskPage для ответа API
+func toAPITaskPage(page calculationService.Page) tasks.TaskPage {
+	result := tasks.TaskPage{Items: make([]tasks.Task, 0, len(page.Calculations))}
+	for _, calc := range page.Calculations {
+		result.Items = append(result.Items, toAPITask(calc))
+	}
+	if page.NextCursor != "" {
+		result.NextCursor = &page.NextCursor
+	}
+	return result
+}
+
+// toAPITask — конвертирует Calculation в Task для ответа API
+func toAPITask(calc calculationService.Calculation) tasks.Task {
+	isDone := calc.Result != ""
+	task := tasks.Task{
+		Id:     &calc.ID,
+		IsDone: &isDone,
+		Task:   calc.Expression,
+		Result: &calc.Result,
+		Engine: &calc.Engine,
+		UserId: &calc.UserID,
+	}
+	if calc.Unit != "" {
+		task.Unit = &calc.Unit
+	}
+	if calc.ResultType != "" {
+		resultType := tasks.TaskResultType(calc.ResultType)
+		task.ResultType = &resultType
+	}
+	if v, ok := calculationService.VectorElements(calc.Result, calc.ResultType); ok {
+		task.Vector = v
+	}
+	if m, ok := calculationService.MatrixElements(calc.Result, calc.ResultType); ok {
+		task.Matrix = m
+	}
+	if len(calc.Variables) > 0 {
+		task.Variables = calc.Variables
+	}
+	if len(calc.Functions) > 0 {
+		task.Functions = calc.Functions
+	}
+	if len(calc.Rates) > 0 {
+		task.Rates = calc.Rates
+	}
+	if !calc.CreatedAt.IsZero() {
+		task.CreatedAt = &calc.CreatedAt
+	}
+	if !calc.UpdatedAt.IsZero() {
+		task.UpdatedAt = &calc.UpdatedAt
+	}
+	if calc.UpdatedBy != "" {
+		task.UpdatedBy = &calc.UpdatedBy
+	}
+	if calc.Version != 0 {
+		task.Version = &calc.Version
+	}
+	if calc.DeletedAt.Valid {
+		task.DeletedAt = &calc.DeletedAt.Time
+	}
+	if calc.Mode != "" {
+		mode := tasks.TaskMode(calc.Mode)
+		task.Mode = &mode
+	}
+	if calc.Precision != 0 {
+		task.Precision = &calc.Precision
+	}
+	if calc.WordType != "" {
+		wordType := tasks.TaskWordType(calc.WordType)
+		task.WordType = &wordType
+	}
+	if b, ok := calculationService.FormatBases(calc.Result, calc.WordType); ok {
+		task.Bases = &tasks.Bases{Bin: b.Bin, Oct: b.Oct, Dec: b.Dec, Hex: b.Hex}
+	}
+	if calc.ComplexForm != "" {
+		form := tasks.TaskComplexForm(calc.ComplexForm)
+		task.ComplexForm = &form
+	}
+	if calc.AngleUnit != "" {
+		unit := tasks.TaskAngleUnit(calc.AngleUnit)
+		task.AngleUnit = &unit
+	}
+	return task
+}
+
+// toAPIRevision — конвертирует Revision в ревизию для ответа API
+func toAPIRevision(rev calculationService.Revision) tasks.Revision {
+	result := tasks.Revision{
+		Number:     rev.Number,
+		Expression: rev.Expression,
+		Result:     rev.Result,
+		Engine:     &rev.Engine,
+		AuthorId:   &rev.AuthorID,
+		CreatedAt:  rev.CreatedAt,
+	}
+	if len(rev.Variables) > 0 {
+		result.Variables = rev.Variables
+	}
+	if len(rev.Functions) > 0 {
+		result.Functions = rev.Functions
+	}
+	if len(rev.Rates) > 0 {
+		result.Rates = rev.Rates
+	}
+	if rev.Unit != "" {
+		result.Unit = &rev.Unit
+	}
+	if rev.ResultType != "" {
+		result.ResultType = &rev.ResultType
+	}
+	if rev.Mode != "" {
+		result.Mode = &rev.Mode
+	}
+	if rev.Precision != 0 {
+		result.Precision = &rev.Precision
+	}
+	if rev.WordType != "" {
+		result.WordType = &rev.WordType
+	}
+	if rev.ComplexForm != "" {
+		result.ComplexForm = &rev.ComplexForm
+	}
+	if rev.AngleUnit != "" {
+		result.AngleUnit = &rev.AngleUnit
+	}
+	return result
+}
+
+// toAPIRevisionDiff — конвертирует RevisionDiff для ответа API
+func toAPIRevisionDiff(diff calculationService.RevisionDiff) tasks.RevisionDiff {
+	result := tasks.RevisionDiff{
+		From:       diff.From,
+		To:         diff.To,
+		Changes:    make([]tasks.RevisionChange, 0, len(diff.Changes)),
+		Expression: make([]tasks.RevisionEdit, 0, len(diff.Expression)),
+	}
+	for _, c := range diff.Changes {
+		result.Changes = append(result.Changes, tasks.RevisionChange{Field: c.Field, From: c.From, To: c.To})
+	}
+	for _, e := range diff.Expression {
+		result.Expression = append(result.Expression, tasks.RevisionEdit{Op: tasks.RevisionEditOp(e.Op), Text: e.Text})
+	}
+	return result
+}
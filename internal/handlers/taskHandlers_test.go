@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	"CalculatorAppFrontendPantela-main/internal/web/tasks"
+)
+
+// newTaskServer — сервер tasks API поверх repo; все запросы идут от пользователя alice.
+func newTaskServer(repo *calculationService.MockTaskRepository) *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := authService.WithIdentity(c.Request().Context(), authService.Identity{UserID: "alice"})
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	})
+	handler := NewTaskHandler(calculationService.NewCalculationService(repo))
+	tasks.RegisterHandlers(e, tasks.NewStrictHandler(handler, nil))
+	return e
+}
+
+func TestTaskETag(t *testing.T) {
+	repo := new(calculationService.MockTaskRepository)
+	repo.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(calculationService.Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice", Version: 3}, nil)
+	repo.On("UpdateCalculationForUser", mock.Anything, mock.Anything, "alice").Return(nil)
+
+	e := newTaskServer(repo)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tasks/1", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+	req := httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(`{"task": "3*3"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"3"`)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+}
+
+func TestTaskIfMatch(t *testing.T) {
+	current := calculationService.Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice", Version: 3}
+
+	tests := []struct {
+		name    string
+		method  string
+		ifMatch string
+		status  int
+	}{
+		{"PATCH со старой версией", http.MethodPatch, `"2"`, http.StatusPreconditionFailed},
+		{"PATCH с одной из версий", http.MethodPatch, `"2", "3"`, http.StatusOK},
+		{"PATCH с *", http.MethodPatch, `*`, http.StatusOK},
+		{"PATCH со слабым ETag", http.MethodPatch, `W/"3"`, http.StatusPreconditionFailed},
+		{"DELETE со старой версией", http.MethodDelete, `"2"`, http.StatusPreconditionFailed},
+		{"DELETE с текущей версией", http.MethodDelete, `"3"`, http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(calculationService.MockTaskRepository)
+			repo.On("GetCalculationByIDForUser", mock.Anything, "1", "alice").Return(current, nil)
+			repo.On("UpdateCalculationForUser", mock.Anything, mock.Anything, "alice").Return(nil)
+			repo.On("DeleteCalculationForUserIfVersion", mock.Anything, "1", "alice", 3).Return(nil)
+			repo.On("DeleteCalculationForUserIfVersion", mock.Anything, "1", "alice", 2).Return(calculationService.ErrVersionMismatch)
+
+			var body *strings.Reader
+			if tt.method == http.MethodPatch {
+				body = strings.NewReader(`{"task": "3*3"}`)
+			} else {
+				body = strings.NewReader("")
+			}
+			req := httptest.NewRequest(tt.method, "/tasks/1", body)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set("If-Match", tt.ifMatch)
+			rec := httptest.NewRecorder()
+			newTaskServer(repo).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+			if tt.status == http.StatusPreconditionFailed {
+				assert.Contains(t, rec.Body.String(), `"precondition_failed"`)
+			}
+		})
+	}
+}
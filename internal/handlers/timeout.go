@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DBTimeout — middleware, ограничивающая время работы запроса с БД: контекст
+// запроса, который хендлеры передают в сервисы и репозитории, истекает через
+// timeout, и GORM прерывает запросы к БД (ошибка — 503). Закрытое клиентом
+// соединение отменяет контекст и раньше. timeout <= 0 — без ограничения.
+func DBTimeout(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if timeout <= 0 {
+			return next
+		}
+		return func(c echo.Context) error {
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
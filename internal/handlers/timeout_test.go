@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+func TestDBTimeout(t *testing.T) {
+	repo := new(calculationService.MockTaskRepository)
+	withDeadline := mock.MatchedBy(func(ctx context.Context) bool {
+		deadline, ok := ctx.Deadline()
+		return ok && time.Until(deadline) <= time.Minute
+	})
+	repo.On("GetCalculationByIDForUser", withDeadline, "1", "alice").Return(calculationService.Calculation{}, context.DeadlineExceeded)
+
+	e := newTaskServer(repo)
+	e.Use(DBTimeout(time.Minute))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tasks/1", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"code":"timeout"`)
+	repo.AssertExpectations(t)
+}
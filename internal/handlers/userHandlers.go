@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	userService "CalculatorAppFrontendPantela-main/internal/userService"
+	"CalculatorAppFrontendPantela-main/internal/web/tasks"
+	"CalculatorAppFrontendPantela-main/internal/web/users"
+)
+
+// UserHandler — структура, адаптирующая UserService для users API
+type UserHandler struct {
+	service userService.UserService
+}
+
+// NewUserHandler — конструктор для создания нового user хендлера
+func NewUserHandler(s userService.UserService) *UserHandler {
+	return &UserHandler{service: s}
+}
+
+// GetUsers - реализация получения всех пользователей
+func (h *UserHandler) GetUsers(ctx context.Context, request users.GetUsersRequestObject) (users.GetUsersResponseObject, error) {
+	identity, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !identity.IsAdmin {
+		return nil, calculationService.ErrForbidden
+	}
+
+	allUsers, err := h.service.GetAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]users.User, 0, len(allUsers))
+	for _, u := range allUsers {
+		result = append(result, toAPIUser(u))
+	}
+
+	return users.GetUsers200JSONResponse(result), nil
+}
+
+// PostUsers - реализация создания нового пользователя
+func (h *UserHandler) PostUsers(ctx context.Context, request users.PostUsersRequestObject) (users.PostUsersResponseObject, error) {
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
+	}
+
+	user, err := h.service.CreateUser(ctx, string(request.Body.Email), request.Body.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PostUsers201JSONResponse(toAPIUser(user)), nil
+}
+
+// PatchUsersId - реализация обновления пользователя
+func (h *UserHandler) PatchUsersId(ctx context.Context, request users.PatchUsersIdRequestObject) (users.PatchUsersIdResponseObject, error) {
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
+	}
+
+	allowed, err := canAccessUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, userService.ErrUserNotFound
+	}
+
+	user, err := h.service.UpdateUser(ctx, request.Id, string(request.Body.Email), request.Body.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PatchUsersId200JSONResponse(toAPIUser(user)), nil
+}
+
+// DeleteUsersId - реализация удаления пользователя
+func (h *UserHandler) DeleteUsersId(ctx context.Context, request users.DeleteUsersIdRequestObject) (users.DeleteUsersIdResponseObject, error) {
+	allowed, err := canAccessUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, userService.ErrUserNotFound
+	}
+
+	if _, err := h.service.GetUserByID(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteUser(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
+	return users.DeleteUsersId204Response{}, nil
+}
+
+// GetUsersUserIdTasks - реализация получения задач конкретного пользователя
+func (h *UserHandler) GetUsersUserIdTasks(ctx context.Context, request users.GetUsersUserIdTasksRequestObject) (users.GetUsersUserIdTasksResponseObject, error) {
+	allowed, err := canAccessUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, userService.ErrUserNotFound
+	}
+
+	if _, err := h.service.GetUserByID(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	calculations, err := h.service.GetTasksForUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(userTasksResponse, 0, len(calculations))
+	for _, calc := range calculations {
+		result = append(result, toAPITask(calc))
+	}
+	return result, nil
+}
+
+// userTasksResponse — ответ 200 на GET /users/{user_id}/tasks. users.Task и
+// tasks.Task — одна схема Task, поэтому задачи заполняет тот же toAPITask,
+// что и для tasks API, а не отдельная копия преобразования.
+type userTasksResponse []tasks.Task
+
+func (response userTasksResponse) VisitGetUsersUserIdTasksResponse(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// toAPIUser — конвертирует модель пользователя в ответ API (без пароля)
+func toAPIUser(u userService.User) users.User {
+	return users.User{
+		Id:        &u.ID,
+		Email:     &u.Email,
+		IsAdmin:   &u.IsAdmin,
+		CreatedAt: &u.CreatedAt,
+		UpdatedAt: &u.UpdatedAt,
+	}
+}
+
+// canAccessUser — пользователь управляет только своей учётной записью,
+// администратор — любой. Для чужих записей отвечаем 404, как и для задач.
+func canAccessUser(ctx context.Context, userID string) (bool, error) {
+	identity, err := currentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	return identity.IsAdmin || identity.UserID == userID, nil
+}
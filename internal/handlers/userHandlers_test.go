@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	userService "CalculatorAppFrontendPantela-main/internal/userService"
+	"CalculatorAppFrontendPantela-main/internal/web/tasks"
+	"CalculatorAppFrontendPantela-main/internal/web/users"
+)
+
+// TestUserTasksMatchTasks — GET /users/{user_id}/tasks отдаёт задачи в той же
+// записи, что и GET /tasks/{id}, включая version и updated_by.
+func TestUserTasksMatchTasks(t *testing.T) {
+	taskRepo := calculationService.NewMemoryRepository()
+	userSvc := userService.NewUserService(userService.NewMemoryUserRepository(taskRepo))
+	alice, err := userSvc.CreateUser(t.Context(), "alice@example.com", "secret1")
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := authService.WithIdentity(c.Request().Context(), authService.Identity{UserID: alice.ID})
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	})
+	tasks.RegisterHandlers(e, tasks.NewStrictHandler(NewTaskHandler(calculationService.NewCalculationService(taskRepo)), nil))
+	users.RegisterHandlers(e, users.NewStrictHandler(NewUserHandler(userSvc), nil))
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, body := range []string{
+		`{"task": "2+2"}`,
+		`{"task": "[[1,2],[3,4]] * [5,6]", "mode": "matrix"}`,
+		`{"task": "0xff + 1", "mode": "programmer", "word_type": "uint8"}`,
+		`{"task": "3 km + 500 m", "mode": "units"}`,
+	} {
+		rec := serve(http.MethodPost, "/tasks", body)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	var created map[string]any
+	rec := serve(http.MethodPost, "/tasks", `{"task": "1+1"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	rec = serve(http.MethodPatch, "/tasks/"+created["id"].(string), `{"task": "3*3"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = serve(http.MethodGet, "/users/"+alice.ID+"/tasks", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var userTasks []map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &userTasks))
+	require.Len(t, userTasks, 5)
+
+	for _, got := range userTasks {
+		rec := serve(http.MethodGet, "/tasks/"+got["id"].(string), "")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var want map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &want))
+		assert.Equal(t, want, got)
+		assert.Contains(t, got, "version")
+		assert.Contains(t, got, "updated_by")
+	}
+}
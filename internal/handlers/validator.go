@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/labstack/echo/v4"
+)
+
+// ValidatorConfig — настройки проверки запросов и ответов по спецификации.
+type ValidatorConfig struct {
+	// Specs — спецификации из сгенерированных пакетов (tasks.GetSwagger() и
+	// т. д.): каждый пакет встраивает только пути своего тега.
+	Specs []*openapi3.T
+
+	// ValidateResponses — проверять и ответы; расхождения пишутся в лог.
+	ValidateResponses bool
+
+	// Strict — режим для тестов: ответ, не соответствующий спецификации,
+	// заменяется ошибкой 500. Включает ValidateResponses.
+	Strict bool
+}
+
+// OpenAPIValidator — middleware, проверяющая запросы (и при необходимости
+// ответы) по openapi.yaml. Некорректный запрос получает 400 в формате
+// problem+json, не доходя до хендлера. Пути, которых нет в спецификации
+// (например, /calculations), пропускаются без проверки. Авторизацию
+// проверяет JWTAuth, поэтому схемы security здесь не проверяются.
+func OpenAPIValidator(cfg ValidatorConfig) (echo.MiddlewareFunc, error) {
+	specRouters := make([]routers.Router, 0, len(cfg.Specs))
+	for _, spec := range cfg.Specs {
+		router, err := legacy.NewRouter(spec)
+		if err != nil {
+			return nil, fmt.Errorf("openapi router: %w", err)
+		}
+		specRouters = append(specRouters, router)
+	}
+	validateResponses := cfg.ValidateResponses || cfg.Strict
+
+	options := &openapi3filter.Options{
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		// Ответы, которых нет в спецификации, — тоже расхождение.
+		IncludeResponseStatus: true,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			route, pathParams, ok := findRoute(specRouters, req)
+			if !ok {
+				return next(c)
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if err := openapi3filter.ValidateRequest(req.Context(), input); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, requestErrorDetail(err)).SetInternal(err)
+			}
+			if !validateResponses {
+				return next(c)
+			}
+
+			// Ответ копится в буфере, чтобы проверить его до отправки клиенту.
+			res := c.Response()
+			original := res.Writer
+			buffer := &bufferedWriter{header: http.Header{}, status: http.StatusOK}
+			res.Writer = buffer
+			if err := next(c); err != nil {
+				c.Error(err) // тело ошибки тоже должно соответствовать спецификации
+			}
+			res.Writer = original
+
+			err := validateResponse(input, buffer)
+			if err != nil && cfg.Strict {
+				res.Committed = false
+				res.Size = 0
+				return echo.NewHTTPError(http.StatusInternalServerError, "response does not match the OpenAPI spec").SetInternal(err)
+			}
+			if err != nil {
+				c.Logger().Warnf("response %s %s does not match the OpenAPI spec: %v", req.Method, req.URL.Path, err)
+			}
+			return buffer.flush(original)
+		}
+	}, nil
+}
+
+// findRoute — операция спецификации для запроса; ищется во всех спецификациях.
+func findRoute(specRouters []routers.Router, req *http.Request) (*routers.Route, map[string]string, bool) {
+	for _, router := range specRouters {
+		route, pathParams, err := router.FindRoute(req)
+		if err == nil {
+			return route, pathParams, true
+		}
+	}
+	return nil, nil, false
+}
+
+// validateResponse — проверка накопленного ответа. Ответы 5xx в спецификации
+// не описаны и не проверяются.
+func validateResponse(input *openapi3filter.RequestValidationInput, buffer *bufferedWriter) error {
+	if buffer.status >= http.StatusInternalServerError {
+		return nil
+	}
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 buffer.status,
+		Header:                 buffer.header,
+		Options:                input.Options,
+	}
+	responseInput.SetBodyBytes(buffer.body.Bytes())
+	return openapi3filter.ValidateResponse(input.Request.Context(), responseInput)
+}
+
+// requestErrorDetail — краткое описание ошибки проверки запроса без
+// дампа схемы: где ошибка (параметр или тело) и что с ней не так.
+func requestErrorDetail(err error) string {
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return err.Error()
+	}
+
+	where := "request"
+	switch {
+	case requestErr.Parameter != nil:
+		where = fmt.Sprintf("parameter %q in %s", requestErr.Parameter.Name, requestErr.Parameter.In)
+	case requestErr.RequestBody != nil:
+		where = "request body"
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			where += " /" + strings.Join(pointer, "/")
+		}
+		return where + ": " + schemaErr.Reason
+	}
+	if requestErr.Reason != "" {
+		return where + ": " + requestErr.Reason
+	}
+	if requestErr.Err != nil {
+		return where + ": " + requestErr.Err.Error()
+	}
+	return where + " is invalid"
+}
+
+// bufferedWriter — http.ResponseWriter, копящий ответ в памяти.
+type bufferedWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) Header() http.Header { return w.header }
+
+func (w *bufferedWriter) WriteHeader(status int) { w.status = status }
+
+func (w *bufferedWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+
+// flush — отправляет накопленный ответ в настоящий writer.
+func (w *bufferedWriter) flush(dst http.ResponseWriter) error {
+	for key, values := range w.header {
+		dst.Header()[key] = values
+	}
+	dst.WriteHeader(w.status)
+	_, err := dst.Write(w.body.Bytes())
+	return err
+}
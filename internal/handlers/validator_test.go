@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authService "CalculatorAppFrontendPantela-main/internal/authService"
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	"CalculatorAppFrontendPantela-main/internal/web/auth"
+	"CalculatorAppFrontendPantela-main/internal/web/functions"
+	"CalculatorAppFrontendPantela-main/internal/web/tasks"
+	"CalculatorAppFrontendPantela-main/internal/web/users"
+	"CalculatorAppFrontendPantela-main/internal/web/variables"
+)
+
+// badModeTasks — tasks API, отвечающий на GET /tasks задачей с режимом не из спецификации.
+type badModeTasks struct {
+	*TaskHandler
+}
+
+func (badModeTasks) GetTasks(ctx context.Context, request tasks.GetTasksRequestObject) (tasks.GetTasksResponseObject, error) {
+	mode := tasks.TaskMode("bogus")
+	return tasks.GetTasks200JSONResponse{Items: []tasks.Task{{Task: "1+1", Mode: &mode}}}, nil
+}
+
+// newValidatedServer — сервер tasks API с проверкой по спецификации;
+// все запросы идут от пользователя user-1.
+func newValidatedServer(t *testing.T, cfg ValidatorConfig, server func(*TaskHandler) tasks.StrictServerInterface) *echo.Echo {
+	spec, err := tasks.GetSwagger()
+	require.NoError(t, err)
+	cfg.Specs = []*openapi3.T{spec}
+	validator, err := OpenAPIValidator(cfg)
+	require.NoError(t, err)
+
+	repo := new(calculationService.MockTaskRepository)
+	repo.On("CreateCalculation", mock.Anything, mock.Anything).Return(nil)
+	handler := NewTaskHandler(calculationService.NewCalculationService(repo))
+
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := authService.WithIdentity(c.Request().Context(), authService.Identity{UserID: "user-1"})
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	})
+	e.Use(validator)
+	tasks.RegisterHandlers(e, tasks.NewStrictHandler(server(handler), nil))
+	return e
+}
+
+func plainTasks(h *TaskHandler) tasks.StrictServerInterface { return h }
+
+func TestOpenAPIValidatorRequests(t *testing.T) {
+	e := newValidatedServer(t, ValidatorConfig{Strict: true}, plainTasks)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		code   string
+		detail string
+	}{
+		{"нет task", http.MethodPost, "/tasks", `{}`, http.StatusBadRequest, "invalid_request", `property "task" is missing`},
+		{"неверный тип", http.MethodPost, "/tasks", `{"task": 42}`, http.StatusBadRequest, "invalid_request", "/task"},
+		{"режим не из списка", http.MethodPost, "/tasks", `{"task": "1+1", "mode": "fast"}`, http.StatusBadRequest, "invalid_request", "/mode"},
+		{"точность вне диапазона", http.MethodPost, "/tasks", `{"task": "1+1", "mode": "decimal", "precision": 0}`, http.StatusBadRequest, "invalid_request", "/precision"},
+		{"корректная задача", http.MethodPost, "/tasks", `{"task": "2+2"}`, http.StatusCreated, "", ""},
+		{"ошибка сервиса по спецификации", http.MethodPost, "/tasks", `{"task": "1/0", "engine": "bignum"}`, http.StatusUnprocessableEntity, "division_by_zero", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+			if tt.code == "" {
+				return
+			}
+			assert.Equal(t, ProblemContentType, rec.Header().Get(echo.HeaderContentType))
+			var p Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+			assert.Equal(t, tt.code, p.Code)
+			assert.Contains(t, p.Detail, tt.detail)
+		})
+	}
+}
+
+func TestOpenAPIValidatorResponses(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    ValidatorConfig
+		status int
+	}{
+		{"строгий режим", ValidatorConfig{Strict: true}, http.StatusInternalServerError},
+		{"только журнал", ValidatorConfig{ValidateResponses: true}, http.StatusOK},
+		{"без проверки ответов", ValidatorConfig{}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newValidatedServer(t, tt.cfg, func(h *TaskHandler) tasks.StrictServerInterface { return badModeTasks{h} })
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tasks", nil))
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestOpenAPIValidatorLoadsAllSpecs(t *testing.T) {
+	var specs []*openapi3.T
+	for _, load := range []func() (*openapi3.T, error){tasks.GetSwagger, users.GetSwagger, auth.GetSwagger, variables.GetSwagger, functions.GetSwagger} {
+		spec, err := load()
+		require.NoError(t, err)
+		specs = append(specs, spec)
+	}
+	_, err := OpenAPIValidator(ValidatorConfig{Specs: specs})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	variableService "CalculatorAppFrontendPantela-main/internal/variableService"
+	"CalculatorAppFrontendPantela-main/internal/web/variables"
+)
+
+// VariableHandler — структура, адаптирующая VariableService для variables API
+type VariableHandler struct {
+	service variableService.VariableService
+}
+
+// NewVariableHandler — конструктор для создания нового variable хендлера
+func NewVariableHandler(s variableService.VariableService) *VariableHandler {
+	return &VariableHandler{service: s}
+}
+
+// GetVariables - реализация получения переменных текущего пользователя
+func (h *VariableHandler) GetVariables(ctx context.Context, request variables.GetVariablesRequestObject) (variables.GetVariablesResponseObject, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := h.service.ListVariables(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]variables.Variable, 0, len(vars))
+	for _, v := range vars {
+		result = append(result, toAPIVariable(v))
+	}
+
+	return variables.GetVariables200JSONResponse(result), nil
+}
+
+// PostVariables - реализация создания переменной
+func (h *VariableHandler) PostVariables(ctx context.Context, request variables.PostVariablesRequestObject) (variables.PostVariablesResponseObject, error) {
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
+	}
+
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var description string
+	if request.Body.Description != nil {
+		description = *request.Body.Description
+	}
+
+	v, err := h.service.CreateVariable(ctx, user.UserID, request.Body.Name, formatValue(request.Body.Value), description)
+	if err != nil {
+		return nil, err
+	}
+
+	return variables.PostVariables201JSONResponse(toAPIVariable(v)), nil
+}
+
+// GetVariablesName - реализация получения переменной по имени
+func (h *VariableHandler) GetVariablesName(ctx context.Context, request variables.GetVariablesNameRequestObject) (variables.GetVariablesNameResponseObject, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := h.service.GetVariable(ctx, user.UserID, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return variables.GetVariablesName200JSONResponse(toAPIVariable(v)), nil
+}
+
+// PatchVariablesName - реализация изменения значения переменной
+func (h *VariableHandler) PatchVariablesName(ctx context.Context, request variables.PatchVariablesNameRequestObject) (variables.PatchVariablesNameResponseObject, error) {
+	if request.Body == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body is required")
+	}
+
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := h.service.UpdateVariable(ctx, user.UserID, request.Name, formatValue(request.Body.Value), request.Body.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return variables.PatchVariablesName200JSONResponse(toAPIVariable(v)), nil
+}
+
+// DeleteVariablesName - реализация удаления переменной
+func (h *VariableHandler) DeleteVariablesName(ctx context.Context, request variables.DeleteVariablesNameRequestObject) (variables.DeleteVariablesNameResponseObject, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.DeleteVariable(ctx, user.UserID, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return variables.DeleteVariablesName204Response{}, nil
+}
+
+// GetConstants - реализация получения встроенных констант
+func (h *VariableHandler) GetConstants(ctx context.Context, request variables.GetConstantsRequestObject) (variables.GetConstantsResponseObject, error) {
+	constants := calculationService.Constants()
+	result := make([]variables.Constant, 0, len(constants))
+	for _, c := range constants {
+		description := c.Description
+		result = append(result, variables.Constant{Name: c.Name, Value: c.Value, Description: &description})
+	}
+	return variables.GetConstants200JSONResponse(result), nil
+}
+
+// formatValue — кратчайшая десятичная запись числа из JSON
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// toAPIVariable — конвертирует Variable в ответ API
+func toAPIVariable(v variableService.Variable) variables.Variable {
+	value, _ := strconv.ParseFloat(v.Value, 64)
+	return variables.Variable{
+		Name:        v.Name,
+		Value:       value,
+		Description: &v.Description,
+		CreatedAt:   &v.CreatedAt,
+		UpdatedAt:   &v.UpdatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package userService
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+// memoryUserRepository — UserRepository в памяти процесса с той же
+// семантикой, что и у userRepository: уникальные ID и email
+// (gorm.ErrDuplicatedKey), gorm.ErrRecordNotFound для неизвестных.
+type memoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[string]User
+	tasks calculationService.CalculationRepository
+}
+
+// NewMemoryUserRepository — пустой репозиторий в памяти; задачи
+// пользователей он берёт из tasks.
+func NewMemoryUserRepository(tasks calculationService.CalculationRepository) UserRepository {
+	return &memoryUserRepository{users: make(map[string]User), tasks: tasks}
+}
+
+func (r *memoryUserRepository) CreateUser(ctx context.Context, user User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[user.ID]; ok || r.emailTaken(user) {
+		return gorm.ErrDuplicatedKey
+	}
+	now := time.Now()
+	if user.CreatedAt.IsZero() {
+		user.CreatedAt = now
+	}
+	if user.UpdatedAt.IsZero() {
+		user.UpdatedAt = now
+	}
+	r.users[user.ID] = user.clone()
+	return nil
+}
+
+// GetAllUsers — все пользователи по времени регистрации.
+func (r *memoryUserRepository) GetAllUsers(ctx context.Context) ([]User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	users := make([]User, 0, len(r.users))
+	for _, user := range r.users {
+		users = append(users, user.clone())
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if !users[i].CreatedAt.Equal(users[j].CreatedAt) {
+			return users[i].CreatedAt.Before(users[j].CreatedAt)
+		}
+		return users[i].ID < users[j].ID
+	})
+	return users, nil
+}
+
+func (r *memoryUserRepository) GetUserByID(ctx context.Context, id string) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	user, ok := r.users[id]
+	if !ok {
+		return User{}, gorm.ErrRecordNotFound
+	}
+	return user.clone(), nil
+}
+
+func (r *memoryUserRepository) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, user := range r.users {
+		if user.Email == email {
+			return user.clone(), nil
+		}
+	}
+	return User{}, gorm.ErrRecordNotFound
+}
+
+// UpdateUser — как Save в GORM: сохраняет пользователя целиком, а
+// несуществующего создаёт.
+func (r *memoryUserRepository) UpdateUser(ctx context.Context, user User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.emailTaken(user) {
+		return gorm.ErrDuplicatedKey
+	}
+	user.UpdatedAt = time.Now()
+	r.users[user.ID] = user.clone()
+	return nil
+}
+
+func (r *memoryUserRepository) DeleteUser(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.users, id)
+	return nil
+}
+
+func (r *memoryUserRepository) GetTasksForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error) {
+	return r.tasks.GetAllCalculationsForUser(ctx, userID)
+}
+
+// emailTaken — email пользователя занят другим пользователем. Вызывается
+// под блокировкой.
+func (r *memoryUserRepository) emailTaken(user User) bool {
+	for _, other := range r.users {
+		if other.ID != user.ID && other.Email == user.Email {
+			return true
+		}
+	}
+	return false
+}
+
+// clone — копия пользователя без задач (их хранит репозиторий задач) и
+// с собственной копией DeletedAt.
+func (u User) clone() User {
+	u.Tasks = nil
+	if u.DeletedAt != nil {
+		at := *u.DeletedAt
+		u.DeletedAt = &at
+	}
+	return u
+}
@@ -8,12 +8,13 @@ import (
 
 // User — модель пользователя
 type User struct {
-	ID        string                              `gorm:"primaryKey" json:"id"`
-	Email     string                              `gorm:"unique;not null" json:"email"`
-	Password  string                              `gorm:"not null" json:"-"` // Не возвращаем пароль в JSON
-	DeletedAt *time.Time                         `json:"deleted_at,omitempty"`
-	CreatedAt time.Time                           `json:"created_at"`
-	UpdatedAt time.Time                           `json:"updated_at"`
+	ID        string                           `gorm:"primaryKey" json:"id"`
+	Email     string                           `gorm:"unique;not null" json:"email"`
+	Password  string                           `gorm:"not null" json:"-"`                      // Не возвращаем пароль в JSON
+	IsAdmin   bool                             `gorm:"not null;default:false" json:"is_admin"` // Видит и меняет записи всех пользователей
+	DeletedAt *time.Time                       `json:"deleted_at,omitempty"`
+	CreatedAt time.Time                        `json:"created_at"`
+	UpdatedAt time.Time                        `json:"updated_at"`
 	Tasks     []calculationService.Calculation `gorm:"foreignKey:UserID" json:"tasks,omitempty"`
 }
 
@@ -1,6 +1,7 @@
 package userService
 
 import (
+	"context"
 	"gorm.io/gorm"
 
 	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
@@ -8,12 +9,13 @@ import (
 
 // UserRepository — интерфейс для работы с пользователями в БД
 type UserRepository interface {
-	CreateUser(user User) error
-	GetAllUsers() ([]User, error)
-	GetUserByID(id string) (User, error)
-	UpdateUser(user User) error
-	DeleteUser(id string) error
-	GetTasksForUser(userID string) ([]calculationService.Calculation, error)
+	CreateUser(ctx context.Context, user User) error
+	GetAllUsers(ctx context.Context) ([]User, error)
+	GetUserByID(ctx context.Context, id string) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	UpdateUser(ctx context.Context, user User) error
+	DeleteUser(ctx context.Context, id string) error
+	GetTasksForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error)
 }
 
 type userRepository struct {
@@ -25,32 +27,38 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{db: db}
 }
 
-func (r *userRepository) CreateUser(user User) error {
-	return r.db.Create(&user).Error
+func (r *userRepository) CreateUser(ctx context.Context, user User) error {
+	return r.db.WithContext(ctx).Create(&user).Error
 }
 
-func (r *userRepository) GetAllUsers() ([]User, error) {
+func (r *userRepository) GetAllUsers(ctx context.Context) ([]User, error) {
 	var users []User
-	err := r.db.Find(&users).Error
+	err := r.db.WithContext(ctx).Find(&users).Error
 	return users, err
 }
 
-func (r *userRepository) GetUserByID(id string) (User, error) {
+func (r *userRepository) GetUserByID(ctx context.Context, id string) (User, error) {
 	var user User
-	err := r.db.First(&user, "id = ?", id).Error
+	err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error
 	return user, err
 }
 
-func (r *userRepository) UpdateUser(user User) error {
-	return r.db.Save(&user).Error
+func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	var user User
+	err := r.db.WithContext(ctx).First(&user, "email = ?", email).Error
+	return user, err
+}
+
+func (r *userRepository) UpdateUser(ctx context.Context, user User) error {
+	return r.db.WithContext(ctx).Save(&user).Error
 }
 
-func (r *userRepository) DeleteUser(id string) error {
-	return r.db.Delete(&User{}, "id = ?", id).Error
+func (r *userRepository) DeleteUser(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&User{}, "id = ?", id).Error
 }
 
-func (r *userRepository) GetTasksForUser(userID string) ([]calculationService.Calculation, error) {
+func (r *userRepository) GetTasksForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error) {
 	var tasks []calculationService.Calculation
-	err := r.db.Where("user_id = ?", userID).Find(&tasks).Error
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&tasks).Error
 	return tasks, err
 }
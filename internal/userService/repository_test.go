@@ -0,0 +1,80 @@
+package userService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+	"CalculatorAppFrontendPantela-main/internal/db/dbtest"
+)
+
+// repositories — реализации UserRepository, которые должны пройти общие
+// тесты ниже; new возвращает пустые репозитории пользователей и их задач.
+var repositories = []struct {
+	name string
+	new  func(t *testing.T) (UserRepository, calculationService.CalculationRepository)
+}{
+	{"sqlite", func(t *testing.T) (UserRepository, calculationService.CalculationRepository) {
+		conn := dbtest.New(t)
+		return NewUserRepository(conn), calculationService.NewCalculationRepository(conn)
+	}},
+	{"memory", func(*testing.T) (UserRepository, calculationService.CalculationRepository) {
+		tasks := calculationService.NewMemoryRepository()
+		return NewMemoryUserRepository(tasks), tasks
+	}},
+}
+
+func TestUserRepository(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, impl := range repositories {
+		t.Run(impl.name, func(t *testing.T) {
+			repo, calcs := impl.new(t)
+
+			alice := User{ID: "alice", Email: "alice@example.com", Password: "hash", CreatedAt: created, UpdatedAt: created}
+			require.NoError(t, repo.CreateUser(t.Context(), alice))
+			require.NoError(t, repo.CreateUser(t.Context(), User{ID: "bob", Email: "bob@example.com", Password: "hash", IsAdmin: true, CreatedAt: created.Add(time.Hour)}))
+			assert.ErrorIs(t, repo.CreateUser(t.Context(), User{ID: "eve", Email: "alice@example.com", Password: "hash"}), gorm.ErrDuplicatedKey, "email is unique")
+			assert.ErrorIs(t, repo.CreateUser(t.Context(), User{ID: "alice", Email: "eve@example.com", Password: "hash"}), gorm.ErrDuplicatedKey, "id is unique")
+
+			got, err := repo.GetUserByEmail(t.Context(), "alice@example.com")
+			require.NoError(t, err)
+			assert.Equal(t, "alice", got.ID)
+			assert.False(t, got.IsAdmin)
+			assert.True(t, created.Equal(got.CreatedAt))
+
+			_, err = repo.GetUserByEmail(t.Context(), "eve@example.com")
+			assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+			got.Email = "bob@example.com"
+			assert.ErrorIs(t, repo.UpdateUser(t.Context(), got), gorm.ErrDuplicatedKey, "email of another user")
+			got.Email = "alice@example.org"
+			got.IsAdmin = true
+			require.NoError(t, repo.UpdateUser(t.Context(), got))
+			got, err = repo.GetUserByID(t.Context(), "alice")
+			require.NoError(t, err)
+			assert.Equal(t, "alice@example.org", got.Email)
+			assert.True(t, got.IsAdmin)
+
+			users, err := repo.GetAllUsers(t.Context())
+			require.NoError(t, err)
+			assert.Len(t, users, 2)
+
+			require.NoError(t, calcs.CreateCalculation(t.Context(), calculationService.Calculation{ID: "1", Expression: "2+2", Result: "4", UserID: "alice"}))
+			require.NoError(t, calcs.CreateCalculation(t.Context(), calculationService.Calculation{ID: "2", Expression: "3+3", Result: "6", UserID: "bob"}))
+			tasks, err := repo.GetTasksForUser(t.Context(), "alice")
+			require.NoError(t, err)
+			require.Len(t, tasks, 1)
+			assert.Equal(t, "1", tasks[0].ID)
+
+			require.NoError(t, repo.DeleteUser(t.Context(), "bob"))
+			_, err = repo.GetUserByID(t.Context(), "bob")
+			assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			require.NoError(t, repo.CreateUser(t.Context(), User{ID: "carol", Email: "bob@example.com", Password: "hash"}), "email is free again")
+		})
+	}
+}
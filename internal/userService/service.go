@@ -1,20 +1,33 @@
 package userService
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 
 	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
 )
 
+var (
+	// ErrUserNotFound — пользователь с указанным ID не существует.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInvalidCredentials — неверный email или пароль.
+	ErrInvalidCredentials = errors.New("invalid email or password")
+)
+
 // UserService — интерфейс бизнес-логики
 type UserService interface {
-	CreateUser(email, password string) (User, error)
-	GetAllUsers() ([]User, error)
-	GetUserByID(id string) (User, error)
-	UpdateUser(id, email, password string) (User, error)
-	DeleteUser(id string) error
-	GetTasksForUser(userID string) ([]calculationService.Calculation, error)
+	CreateUser(ctx context.Context, email, password string) (User, error)
+	GetAllUsers(ctx context.Context) ([]User, error)
+	GetUserByID(ctx context.Context, id string) (User, error)
+	Authenticate(ctx context.Context, email, password string) (User, error)
+	UpdateUser(ctx context.Context, id, email, password string) (User, error)
+	DeleteUser(ctx context.Context, id string) error
+	GetTasksForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error)
 }
 
 type userService struct {
@@ -32,56 +45,96 @@ func (s *userService) hashPassword(password string) (string, error) {
 	return string(bytes), err
 }
 
-func (s *userService) CreateUser(email, password string) (User, error) {
+func (s *userService) CreateUser(ctx context.Context, email, password string) (User, error) {
 	hashedPassword, err := s.hashPassword(password)
 	if err != nil {
 		return User{}, err
 	}
 
+	// Временные метки ставим сами: репозиторий принимает копию,
+	// и значения, проставленные GORM, до вызывающего не доходят.
+	now := time.Now()
 	user := User{
-		ID:       uuid.NewString(),
-		Email:    email,
-		Password: hashedPassword,
+		ID:        uuid.NewString(),
+		Email:     email,
+		Password:  hashedPassword,
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
-	if err := s.repo.CreateUser(user); err != nil {
+	if err := s.repo.CreateUser(ctx, user); err != nil {
 		return User{}, err
 	}
 
 	return user, nil
 }
 
-func (s *userService) GetAllUsers() ([]User, error) {
-	return s.repo.GetAllUsers()
+func (s *userService) GetAllUsers(ctx context.Context) ([]User, error) {
+	return s.repo.GetAllUsers(ctx)
 }
 
-func (s *userService) GetUserByID(id string) (User, error) {
-	return s.repo.GetUserByID(id)
+func (s *userService) GetUserByID(ctx context.Context, id string) (User, error) {
+	user, err := s.repo.GetUserByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return User{}, ErrUserNotFound
+	}
+	return user, err
 }
 
-func (s *userService) UpdateUser(id, email, password string) (User, error) {
-	hashedPassword, err := s.hashPassword(password)
+// dummyHash — хеш bcrypt со стоимостью bcrypt.DefaultCost, с которым
+// Authenticate сравнивает пароль, когда пользователя с таким email нет:
+// проверка тогда занимает столько же времени, сколько для существующего.
+var dummyHash = []byte("$2a$10$lYcjMTZ6tZ5mqV32oAMXt.3JhO7x8FyXRqA9qygK2nTKxbstWmtYe")
+
+// Authenticate — проверяет email и пароль и возвращает пользователя.
+// Для неизвестного email и неверного пароля ошибка и время ответа
+// одинаковые, чтобы по ответу нельзя было перебирать зарегистрированные адреса.
+func (s *userService) Authenticate(ctx context.Context, email, password string) (User, error) {
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// bcrypt и здесь, иначе неизвестный email выдаёт быстрый ответ.
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return User{}, ErrInvalidCredentials
+	}
 	if err != nil {
 		return User{}, err
 	}
 
-	user := User{
-		ID:       id,
-		Email:    email,
-		Password: hashedPassword,
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return User{}, ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+// UpdateUser — обновляет email и пароль существующего пользователя.
+// Несуществующий ID не создаёт новую запись, а возвращает ErrUserNotFound.
+func (s *userService) UpdateUser(ctx context.Context, id, email, password string) (User, error) {
+	user, err := s.GetUserByID(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return User{}, err
 	}
 
-	if err := s.repo.UpdateUser(user); err != nil {
+	user.Email = email
+	user.Password = hashedPassword
+	user.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
 		return User{}, err
 	}
 
 	return user, nil
 }
 
-func (s *userService) DeleteUser(id string) error {
-	return s.repo.DeleteUser(id)
+func (s *userService) DeleteUser(ctx context.Context, id string) error {
+	return s.repo.DeleteUser(ctx, id)
 }
 
-func (s *userService) GetTasksForUser(userID string) ([]calculationService.Calculation, error) {
-	return s.repo.GetTasksForUser(userID)
+func (s *userService) GetTasksForUser(ctx context.Context, userID string) ([]calculationService.Calculation, error) {
+	return s.repo.GetTasksForUser(ctx, userID)
 }
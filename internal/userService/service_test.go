@@ -0,0 +1,32 @@
+package userService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+func TestAuthenticate(t *testing.T) {
+	// Неизвестный email проверяется по dummyHash той же стоимости, что и
+	// настоящие пароли, — иначе время ответа выдаёт существующие адреса.
+	cost, err := bcrypt.Cost(dummyHash)
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.DefaultCost, cost)
+
+	service := NewUserService(NewMemoryUserRepository(calculationService.NewMemoryRepository()))
+	alice, err := service.CreateUser(t.Context(), "alice@example.com", "secret1")
+	require.NoError(t, err)
+
+	got, err := service.Authenticate(t.Context(), "alice@example.com", "secret1")
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, got.ID)
+
+	_, err = service.Authenticate(t.Context(), "alice@example.com", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = service.Authenticate(t.Context(), "eve@example.com", "secret1")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
@@ -0,0 +1,15 @@
+package variableService
+
+import "time"
+
+// Variable — именованное значение пользователя, доступное в его выражениях
+// (например, tax = 0.2 для выражения price*(1+tax)).
+type Variable struct {
+	ID          string    `gorm:"primaryKey" json:"id"`
+	UserID      string    `gorm:"not null;uniqueIndex:idx_variables_user_name" json:"user_id"`      // Владелец переменной
+	Name        string    `gorm:"not null;size:64;uniqueIndex:idx_variables_user_name" json:"name"` // Имя, уникальное в пределах пользователя
+	Value       string    `gorm:"not null" json:"value"`                                            // Десятичная запись значения, например "0.2"
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
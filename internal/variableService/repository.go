@@ -0,0 +1,68 @@
+package variableService
+
+import (
+	"context"
+	"gorm.io/gorm"
+)
+
+// VariableRepository — интерфейс для хранения переменных пользователей
+type VariableRepository interface {
+	CreateVariable(ctx context.Context, v Variable) error
+	GetVariablesForUser(ctx context.Context, userID string) ([]Variable, error)
+	GetVariable(ctx context.Context, userID, name string) (Variable, error)
+	UpdateVariable(ctx context.Context, v Variable) error
+	DeleteVariable(ctx context.Context, userID, name string) error
+}
+
+type variableRepository struct {
+	db *gorm.DB
+}
+
+// NewVariableRepository — конструктор репозитория
+func NewVariableRepository(db *gorm.DB) VariableRepository {
+	return &variableRepository{db: db}
+}
+
+func (r *variableRepository) CreateVariable(ctx context.Context, v Variable) error {
+	return r.db.WithContext(ctx).Create(&v).Error
+}
+
+// GetVariablesForUser — переменные пользователя, отсортированные по имени.
+func (r *variableRepository) GetVariablesForUser(ctx context.Context, userID string) ([]Variable, error) {
+	var vars []Variable
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("name").Find(&vars).Error
+	return vars, err
+}
+
+func (r *variableRepository) GetVariable(ctx context.Context, userID, name string) (Variable, error) {
+	var v Variable
+	err := r.db.WithContext(ctx).First(&v, "user_id = ? AND name = ?", userID, name).Error
+	return v, err
+}
+
+// UpdateVariable — обновляет значение и описание; если записи нет, возвращает gorm.ErrRecordNotFound.
+func (r *variableRepository) UpdateVariable(ctx context.Context, v Variable) error {
+	res := r.db.WithContext(ctx).Model(&Variable{}).Where("id = ?", v.ID).Updates(map[string]interface{}{
+		"value":       v.Value,
+		"description": v.Description,
+		"updated_at":  v.UpdatedAt,
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *variableRepository) DeleteVariable(ctx context.Context, userID, name string) error {
+	res := r.db.WithContext(ctx).Where("user_id = ? AND name = ?", userID, name).Delete(&Variable{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
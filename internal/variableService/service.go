@@ -0,0 +1,166 @@
+package variableService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	calculationService "CalculatorAppFrontendPantela-main/internal/calculationService"
+)
+
+// MaxNameLength — максимальная длина имени переменной.
+const MaxNameLength = 64
+
+var (
+	// ErrVariableNotFound — у пользователя нет переменной с таким именем.
+	ErrVariableNotFound = errors.New("variable not found")
+	// ErrVariableExists — у пользователя уже есть переменная с таким именем.
+	ErrVariableExists = errors.New("variable already exists")
+	// ErrInvalidName — имя не является идентификатором, пригодным для выражений.
+	ErrInvalidName = errors.New("invalid variable name")
+	// ErrReservedName — имя занято встроенной константой, функцией или литералом.
+	ErrReservedName = errors.New("variable name is reserved")
+	// ErrInvalidValue — значение не является конечным десятичным числом.
+	ErrInvalidValue = errors.New("invalid variable value")
+)
+
+// namePattern — идентификатор, который понимают все движки вычислений.
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reserved — литералы govaluate, которые нельзя использовать как имена.
+var reserved = map[string]bool{"true": true, "false": true}
+
+// VariableService — интерфейс бизнес-логики переменных.
+// Реализует calculationService.VariableSource, поэтому сервис вычислений
+// получает значения переменных прямо отсюда.
+type VariableService interface {
+	CreateVariable(ctx context.Context, userID, name, value, description string) (Variable, error)
+	ListVariables(ctx context.Context, userID string) ([]Variable, error)
+	GetVariable(ctx context.Context, userID, name string) (Variable, error)
+	UpdateVariable(ctx context.Context, userID, name, value string, description *string) (Variable, error)
+	DeleteVariable(ctx context.Context, userID, name string) error
+	VariablesForUser(ctx context.Context, userID string) (map[string]string, error)
+}
+
+type variableService struct {
+	repo VariableRepository
+}
+
+// NewVariableService — конструктор сервиса
+func NewVariableService(repo VariableRepository) VariableService {
+	return &variableService{repo: repo}
+}
+
+// validateName — проверяет, что имя можно использовать в выражениях.
+func validateName(name string) error {
+	if len(name) > MaxNameLength || !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	if calculationService.IsConstant(name) || calculationService.IsBuiltinFunction(name) || reserved[name] {
+		return fmt.Errorf("%w: %q", ErrReservedName, name)
+	}
+	return nil
+}
+
+// validateValue — значение должно быть конечным числом в десятичной записи.
+func validateValue(value string) error {
+	if _, ok := new(big.Rat).SetString(value); !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidValue, value)
+	}
+	return nil
+}
+
+func (s *variableService) CreateVariable(ctx context.Context, userID, name, value, description string) (Variable, error) {
+	if err := validateName(name); err != nil {
+		return Variable{}, err
+	}
+	if err := validateValue(value); err != nil {
+		return Variable{}, err
+	}
+
+	if _, err := s.repo.GetVariable(ctx, userID, name); err == nil {
+		return Variable{}, ErrVariableExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return Variable{}, err
+	}
+
+	now := time.Now()
+	v := Variable{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		Name:        name,
+		Value:       value,
+		Description: description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.repo.CreateVariable(ctx, v); err != nil {
+		return Variable{}, err
+	}
+
+	return v, nil
+}
+
+func (s *variableService) ListVariables(ctx context.Context, userID string) ([]Variable, error) {
+	return s.repo.GetVariablesForUser(ctx, userID)
+}
+
+func (s *variableService) GetVariable(ctx context.Context, userID, name string) (Variable, error) {
+	v, err := s.repo.GetVariable(ctx, userID, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Variable{}, ErrVariableNotFound
+	}
+	return v, err
+}
+
+// UpdateVariable — меняет значение; описание меняется, только если передано.
+func (s *variableService) UpdateVariable(ctx context.Context, userID, name, value string, description *string) (Variable, error) {
+	if err := validateValue(value); err != nil {
+		return Variable{}, err
+	}
+
+	v, err := s.GetVariable(ctx, userID, name)
+	if err != nil {
+		return Variable{}, err
+	}
+
+	v.Value = value
+	if description != nil {
+		v.Description = *description
+	}
+	v.UpdatedAt = time.Now()
+	if err := s.repo.UpdateVariable(ctx, v); errors.Is(err, gorm.ErrRecordNotFound) {
+		return Variable{}, ErrVariableNotFound
+	} else if err != nil {
+		return Variable{}, err
+	}
+
+	return v, nil
+}
+
+func (s *variableService) DeleteVariable(ctx context.Context, userID, name string) error {
+	err := s.repo.DeleteVariable(ctx, userID, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrVariableNotFound
+	}
+	return err
+}
+
+// VariablesForUser — значения всех переменных пользователя по имени.
+func (s *variableService) VariablesForUser(ctx context.Context, userID string) (map[string]string, error) {
+	vars, err := s.repo.GetVariablesForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(vars))
+	for _, v := range vars {
+		values[v.Name] = v.Value
+	}
+	return values, nil
+}
@@ -0,0 +1,88 @@
+package variableService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateVariable(t *testing.T) {
+	tests := []struct {
+		name      string
+		varName   string
+		value     string
+		mockSetup func(m *MockVariableRepository)
+		wantErr   error
+	}{
+		{
+			name:    "успешное создание",
+			varName: "tax",
+			value:   "0.2",
+			mockSetup: func(m *MockVariableRepository) {
+				m.On("GetVariable", mock.Anything, "alice", "tax").Return(Variable{}, gorm.ErrRecordNotFound)
+				m.On("CreateVariable", mock.Anything, mock.MatchedBy(func(v Variable) bool {
+					return v.UserID == "alice" && v.Name == "tax" && v.Value == "0.2" && v.ID != ""
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "имя уже занято",
+			varName: "tax",
+			value:   "0.2",
+			mockSetup: func(m *MockVariableRepository) {
+				m.On("GetVariable", mock.Anything, "alice", "tax").Return(Variable{Name: "tax"}, nil)
+			},
+			wantErr: ErrVariableExists,
+		},
+		{name: "имя константы", varName: "pi", value: "3", mockSetup: func(m *MockVariableRepository) {}, wantErr: ErrReservedName},
+		{name: "не идентификатор", varName: "1rate", value: "3", mockSetup: func(m *MockVariableRepository) {}, wantErr: ErrInvalidName},
+		{name: "не число", varName: "rate", value: "abc", mockSetup: func(m *MockVariableRepository) {}, wantErr: ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockVariableRepository)
+			tt.mockSetup(mockRepo)
+
+			service := NewVariableService(mockRepo)
+			v, err := service.CreateVariable(t.Context(), "alice", tt.varName, tt.value, "")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.value, v.Value)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateVariableNotFound(t *testing.T) {
+	mockRepo := new(MockVariableRepository)
+	mockRepo.On("GetVariable", mock.Anything, "alice", "tax").Return(Variable{}, gorm.ErrRecordNotFound)
+
+	service := NewVariableService(mockRepo)
+	_, err := service.UpdateVariable(t.Context(), "alice", "tax", "0.25", nil)
+
+	assert.ErrorIs(t, err, ErrVariableNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestVariablesForUser(t *testing.T) {
+	mockRepo := new(MockVariableRepository)
+	mockRepo.On("GetVariablesForUser", mock.Anything, "alice").Return([]Variable{
+		{Name: "price", Value: "100"},
+		{Name: "tax", Value: "0.2"},
+	}, nil)
+
+	service := NewVariableService(mockRepo)
+	values, err := service.VariablesForUser(t.Context(), "alice")
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"price": "100", "tax": "0.2"}, values)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,39 @@
+package variableService
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockVariableRepository — поддельный репозиторий переменных
+type MockVariableRepository struct {
+	mock.Mock
+}
+
+func (m *MockVariableRepository) CreateVariable(ctx context.Context, v Variable) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockVariableRepository) GetVariablesForUser(ctx context.Context, userID string) ([]Variable, error) {
+	args := m.Called(ctx, userID)
+	if res := args.Get(0); res != nil {
+		return res.([]Variable), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockVariableRepository) GetVariable(ctx context.Context, userID, name string) (Variable, error) {
+	args := m.Called(ctx, userID, name)
+	return args.Get(0).(Variable), args.Error(1)
+}
+
+func (m *MockVariableRepository) UpdateVariable(ctx context.Context, v Variable) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockVariableRepository) DeleteVariable(ctx context.Context, userID, name string) error {
+	args := m.Called(ctx, userID, name)
+	return args.Error(0)
+}
@@ -8,22 +8,478 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for FunctionInfoCategory.
+const (
+	FunctionInfoCategoryTrigonometry  FunctionInfoCategory = "trigonometry"
+	FunctionInfoCategoryHyperbolic    FunctionInfoCategory = "hyperbolic"
+	FunctionInfoCategoryLogarithm     FunctionInfoCategory = "logarithm"
+	FunctionInfoCategoryRoot          FunctionInfoCategory = "root"
+	FunctionInfoCategoryRounding      FunctionInfoCategory = "rounding"
+	FunctionInfoCategoryCombinatorics FunctionInfoCategory = "combinatorics"
+	FunctionInfoCategoryComplex       FunctionInfoCategory = "complex"
+	FunctionInfoCategoryUser          FunctionInfoCategory = "user"
+)
+
+// Defines values for RevisionEditOp.
+const (
+	RevisionEditOpEqual  RevisionEditOp = "equal"
+	RevisionEditOpInsert RevisionEditOp = "insert"
+	RevisionEditOpDelete RevisionEditOp = "delete"
+)
+
+// Defines values for TaskAngleUnit.
+const (
+	TaskAngleUnitRadians TaskAngleUnit = "radians"
+	TaskAngleUnitDegrees TaskAngleUnit = "degrees"
+)
+
+// Defines values for TaskComplexForm.
+const (
+	TaskComplexFormRectangular TaskComplexForm = "rectangular"
+	TaskComplexFormPolar       TaskComplexForm = "polar"
+)
+
+// Defines values for TaskMode.
+const (
+	TaskModeFloat      TaskMode = "float"
+	TaskModeRational   TaskMode = "rational"
+	TaskModeDecimal    TaskMode = "decimal"
+	TaskModeUnits      TaskMode = "units"
+	TaskModeProgrammer TaskMode = "programmer"
+	TaskModeComplex    TaskMode = "complex"
+	TaskModeMatrix     TaskMode = "matrix"
+)
+
+// Defines values for TaskResultType.
+const (
+	TaskResultTypeNumber  TaskResultType = "number"
+	TaskResultTypeComplex TaskResultType = "complex"
+	TaskResultTypeVector  TaskResultType = "vector"
+	TaskResultTypeMatrix  TaskResultType = "matrix"
+)
+
+// Defines values for TaskWordType.
+const (
+	TaskWordTypeInt8   TaskWordType = "int8"
+	TaskWordTypeUint8  TaskWordType = "uint8"
+	TaskWordTypeInt16  TaskWordType = "int16"
+	TaskWordTypeUint16 TaskWordType = "uint16"
+	TaskWordTypeInt32  TaskWordType = "int32"
+	TaskWordTypeUint32 TaskWordType = "uint32"
+	TaskWordTypeInt64  TaskWordType = "int64"
+	TaskWordTypeUint64 TaskWordType = "uint64"
+)
+
+// Defines values for GetTasksParamsSort.
+const (
+	GetTasksParamsSortCreatedAt GetTasksParamsSort = "created_at"
+	GetTasksParamsSortResult    GetTasksParamsSort = "result"
+)
+
+// Defines values for GetTasksParamsOrder.
+const (
+	GetTasksParamsOrderAsc  GetTasksParamsOrder = "asc"
+	GetTasksParamsOrderDesc GetTasksParamsOrder = "desc"
+)
+
+// Defines values for GetTasksParamsStatus.
+const (
+	GetTasksParamsStatusSuccess GetTasksParamsStatus = "success"
+	GetTasksParamsStatusError   GetTasksParamsStatus = "error"
+)
+
+// Bases Result of the programmer mode in every base; negative numbers are written as their two's-complement bit pattern of `word_type` width.
+type Bases struct {
+	Bin string `json:"bin"`
+	Dec string `json:"dec"`
+	Hex string `json:"hex"`
+	Oct string `json:"oct"`
+}
+
+// Constant defines model for Constant.
+type Constant struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	// Decimal value with 64 significant digits
+	Value string `json:"value"`
+}
+
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	Currency string `json:"currency"`
+	// Day the rate takes effect on (UTC)
+	Date openapi_types.Date `json:"date"`
+	// Units of the currency per one unit of the base currency
+	Rate string `json:"rate"`
+}
+
+// FunctionInfo defines model for FunctionInfo.
+type FunctionInfo struct {
+	Category FunctionInfoCategory `json:"category"`
+	// Full definition; present for user functions only
+	Definition  *string `json:"definition,omitempty"`
+	Description string  `json:"description"`
+	// Maximum number of arguments; absent for variadic functions
+	MaxArgs *int `json:"max_args,omitempty"`
+	MinArgs int  `json:"min_args"`
+	// Precision modes in which the function is available
+	Modes []string `json:"modes"`
+	Name  string   `json:"name"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// Problem Error details (RFC 7807), returned as application/problem+json by every endpoint.
+type Problem struct {
+	// Machine-readable error code. 400: invalid_request, invalid_cursor, syntax_error, unknown_variable, unknown_function, unknown_unit, wrong_argument_count, unknown_engine, unsupported_mode, invalid_precision, invalid_word_type, invalid_complex_form, invalid_angle_unit, invalid_id, invalid_function, recursive_function, invalid_variable_name, reserved_variable_name, invalid_variable_value, invalid_rate, expression_too_long, too_many_tokens, expression_too_deep. 401: unauthorized, invalid_credentials, invalid_token. 403: forbidden. 404: not_found. 409: already_exists, function_in_use. 412: precondition_failed. 422: division_by_zero, overflow, domain_error, not_exact, dimension_mismatch, evaluation_timeout, result_too_large. 503: timeout.
+	Code string `json:"code"`
+	// Human-readable explanation of this occurrence
+	Detail *string `json:"detail,omitempty"`
+	// Request path the problem occurred on
+	Instance *string `json:"instance,omitempty"`
+	// Character offset in the expression where the error was found
+	Offset *int `json:"offset,omitempty"`
+	Status int  `json:"status"`
+	// Short summary of the HTTP status
+	Title string `json:"title"`
+	// Token or name at `offset`
+	Token *string `json:"token,omitempty"`
+	// URI reference identifying the problem type, /problems/{code}
+	Type string `json:"type"`
+}
+
+// RateImport defines model for RateImport.
+type RateImport struct {
+	Rates []ExchangeRate `json:"rates"`
+}
+
+// RateImportResult defines model for RateImportResult.
+type RateImportResult struct {
+	Base string `json:"base"`
+	// Rates stored; base currency records are skipped
+	Imported int `json:"imported"`
+}
+
+// RateTable defines model for RateTable.
+type RateTable struct {
+	Base string             `json:"base"`
+	Date openapi_types.Date `json:"date"`
+	// Units of each currency per one unit of the base currency
+	Rates map[string]string `json:"rates"`
+}
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Revision defines model for Revision.
+type Revision struct {
+	AngleUnit *string `json:"angle_unit,omitempty"`
+	// ID of the user who made the change; empty if unknown
+	AuthorId    *string           `json:"author_id,omitempty"`
+	ComplexForm *string           `json:"complex_form,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	Engine      *string           `json:"engine,omitempty"`
+	Expression  string            `json:"expression"`
+	Functions   map[string]string `json:"functions,omitempty"`
+	Mode        *string           `json:"mode,omitempty"`
+	// Revision number, starting at 1
+	Number     int               `json:"number"`
+	Precision  *int              `json:"precision,omitempty"`
+	Rates      map[string]string `json:"rates,omitempty"`
+	Result     string            `json:"result"`
+	ResultType *string           `json:"result_type,omitempty"`
+	Unit       *string           `json:"unit,omitempty"`
+	Variables  map[string]string `json:"variables,omitempty"`
+	WordType   *string           `json:"word_type,omitempty"`
+}
+
+// RevisionChange defines model for RevisionChange.
+type RevisionChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// RevisionDiff defines model for RevisionDiff.
+type RevisionDiff struct {
+	// Fields that differ. Variables and functions are compared by name as "variables.<name>" and "functions.<name>"; an empty value means the name is absent in that revision.
+	Changes []RevisionChange `json:"changes"`
+	// Token-level edit script turning the `from` expression into the `to` expression.
+	Expression []RevisionEdit `json:"expression"`
+	From       int            `json:"from"`
+	To         int            `json:"to"`
+}
+
+// RevisionEdit defines model for RevisionEdit.
+type RevisionEdit struct {
+	Op   RevisionEditOp `json:"op"`
+	Text string         `json:"text"`
+}
+
 // Task defines model for Task.
 type Task struct {
-	Id     *uint   `json:"id,omitempty"`
-	IsDone *bool   `json:"is_done,omitempty"`
+	// Unit of trigonometric function arguments and inverse function results. Empty on create means radians; empty on update keeps the task's unit.
+	AngleUnit *TaskAngleUnit `json:"angle_unit,omitempty"`
+	Bases     *Bases         `json:"bases,omitempty"`
+	// How the complex mode writes complex results (default rectangular). Empty on update keeps the task's form.
+	ComplexForm *TaskComplexForm `json:"complex_form,omitempty"`
+	CreatedAt   *time.Time       `json:"created_at,omitempty"`
+	// When the task was moved to the trash; absent for live tasks
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Expression engine used to evaluate the task. Empty on create selects the server default; empty on update keeps the engine the task was evaluated with.
+	Engine *string `json:"engine,omitempty"`
+	// Definitions of the user functions the expression called, as they were at evaluation time, e.g. "f(x, y) = x^2 + y".
+	Functions map[string]string `json:"functions,omitempty"`
+	Id        *string           `json:"id,omitempty"`
+	IsDone    *bool             `json:"is_done,omitempty"`
+	// Rows of a matrix result; absent for other result types.
+	Matrix [][]float64 `json:"matrix,omitempty"`
+	// Evaluation mode. "float" uses float64; "rational" keeps exact fractions (1/3 stays 1/3); "decimal" rounds to `precision` significant digits; "units" evaluates physical quantities with SI and imperial units ("5 km / 20 min to km/h"), checking dimensions, and money in currencies with imported exchange rates ("120 USD + 35 EUR in GBP") at the rates in effect when the task is evaluated; "programmer" evaluates integers of `word_type` with wrap-around overflow, 0x/0b/0o literals, bitwise & | ^ ~, shifts << >>, power ** and rol/ror/popcount ("0xFF & ~0b1010 << 2"); "complex" evaluates complex128 numbers with imaginary literals and the imaginary unit i ("(3+4i)*(1-2i)", "sqrt(-4)", "abs(3+4i)"); "matrix" evaluates vectors and matrices written as nested lists with det, inv, transpose, trace, dot, cross and norm ("[[1,2],[3,4]] * [5,6]"), failing with dimension_mismatch when sizes do not fit. Empty selects the engine's default mode.
+	Mode *TaskMode `json:"mode,omitempty"`
+	// Significant digits for the decimal mode (default 34).
+	Precision *int `json:"precision,omitempty"`
+	// Exchange rates of the currencies the expression used, as they were at evaluation time: units of each currency per one unit of the base currency.
+	Rates map[string]string `json:"rates,omitempty"`
+	// Result as text; how to read it depends on `result_type`.
 	Result *string `json:"result,omitempty"`
-	Task   *string `json:"task,omitempty"`
+	// "number" is a real number ("4", "1/3", "2.5e-7"); "complex" is a complex number written in `complex_form`: "11-2i" or "5∠0.927295218001612" (modulus∠argument, the argument in `angle_unit`); "vector" and "matrix" are JSON arrays ("[17,39]", "[[1,2],[3,4]]"), also returned structured in `vector` and `matrix`.
+	ResultType *TaskResultType `json:"result_type,omitempty"`
+	Task       string          `json:"task"`
+	// Unit of the result in the units mode, e.g. "km/h"; absent when the result is a plain number.
+	Unit *string `json:"unit,omitempty"`
+	// When the task was last re-evaluated
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// ID of the user who created or last changed the task
+	UpdatedBy *string `json:"updated_by,omitempty"`
+	UserId    *string `json:"user_id,omitempty"`
+	// Values of the variables and constants the expression referenced, as they were at evaluation time.
+	Variables map[string]string `json:"variables,omitempty"`
+	// Elements of a vector result; absent for other result types.
+	Vector []float64 `json:"vector,omitempty"`
+	// Incremented on every change. The ETag header of the task carries the same version.
+	Version *int `json:"version,omitempty"`
+	// Fixed-width integer type for the programmer mode (default int64). Empty on update keeps the task's type.
+	WordType *TaskWordType `json:"word_type,omitempty"`
+}
+
+// TaskPage defines model for TaskPage.
+type TaskPage struct {
+	Items []Task `json:"items"`
+	// Cursor of the next page; null on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	AccessToken string `json:"access_token"`
+	// Access token lifetime in seconds
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Email     *string    `json:"email,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	IsAdmin   *bool      `json:"is_admin,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// UserFunction defines model for UserFunction.
+type UserFunction struct {
+	Body        string     `json:"body"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Definition  *string    `json:"definition,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Name        string     `json:"name"`
+	Params      []string   `json:"params"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// UserFunctionRequest defines model for UserFunctionRequest.
+type UserFunctionRequest struct {
+	// Expression over the parameters, variables, constants and other functions. `^` is exponentiation. Recursion is not allowed.
+	Body        string  `json:"body"`
+	Description *string `json:"description,omitempty"`
+	// Identifier usable in expressions; must not shadow a built-in function or constant
+	Name   string   `json:"name"`
+	Params []string `json:"params"`
+}
+
+// UserFunctionUpdate defines model for UserFunctionUpdate.
+type UserFunctionUpdate struct {
+	Body        *string  `json:"body,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Params      []string `json:"params,omitempty"`
+}
+
+// UserRequest defines model for UserRequest.
+type UserRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// Variable defines model for Variable.
+type Variable struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Value       float64    `json:"value"`
+}
+
+// VariableRequest defines model for VariableRequest.
+type VariableRequest struct {
+	Description *string `json:"description,omitempty"`
+	// Identifier usable in expressions; must not shadow a constant
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+// VariableUpdate defines model for VariableUpdate.
+type VariableUpdate struct {
+	Description *string `json:"description,omitempty"`
+	Value       float64 `json:"value"`
+}
+
+// FunctionName defines model for FunctionName.
+type FunctionName = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// TaskId defines model for TaskId.
+type TaskId = string
+
+// VariableName defines model for VariableName.
+type VariableName = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = Problem
+
+// Conflict defines model for Conflict.
+type Conflict = Problem
+
+// Forbidden defines model for Forbidden.
+type Forbidden = Problem
+
+// NotFound defines model for NotFound.
+type NotFound = Problem
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = Problem
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = Problem
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = Problem
+
+// FunctionInfoCategory defines model for FunctionInfoCategory.
+type FunctionInfoCategory string
+
+// RevisionEditOp defines model for RevisionEditOp.
+type RevisionEditOp string
+
+// TaskAngleUnit defines model for TaskAngleUnit.
+type TaskAngleUnit string
+
+// TaskComplexForm defines model for TaskComplexForm.
+type TaskComplexForm string
+
+// TaskMode defines model for TaskMode.
+type TaskMode string
+
+// TaskResultType defines model for TaskResultType.
+type TaskResultType string
+
+// TaskWordType defines model for TaskWordType.
+type TaskWordType string
+
+// GetTasksParamsSort defines model for GetTasksParamsSort.
+type GetTasksParamsSort string
+
+// GetTasksParamsOrder defines model for GetTasksParamsOrder.
+type GetTasksParamsOrder string
+
+// GetTasksParamsStatus defines model for GetTasksParamsStatus.
+type GetTasksParamsStatus string
+
+// GetTasksParams defines parameters for GetTasks.
+type GetTasksParams struct {
+	// Page size
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Opaque cursor from `next_cursor` of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	// Sort key: creation time, or the numeric value of the result (tasks whose result is not a finite number come last).
+	Sort  *GetTasksParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *GetTasksParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	// Case-insensitive substring of the expression
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+	// Lowest numeric result, inclusive
+	ResultMin *float64 `form:"result_min,omitempty" json:"result_min,omitempty"`
+	// Highest numeric result, inclusive
+	ResultMax *float64 `form:"result_max,omitempty" json:"result_max,omitempty"`
+	// "success" — tasks with a result; "error" — tasks without one (records left by earlier versions that stored failed tasks).
+	Status *GetTasksParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	// Earliest creation time, inclusive
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+	// Latest creation time, exclusive
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+}
+
+// GetTasksTrashParams defines parameters for GetTasksTrash.
+type GetTasksTrashParams struct {
+	// Page size
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Opaque cursor from `next_cursor` of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetTasksIdRevisionsDiffParams defines parameters for GetTasksIdRevisionsDiff.
+type GetTasksIdRevisionsDiffParams struct {
+	// Number of the older revision
+	From int `form:"from" json:"from"`
+	// Number of the newer revision
+	To int `form:"to" json:"to"`
+}
+
+// DeleteTasksIdParams defines parameters for DeleteTasksId.
+type DeleteTasksIdParams struct {
+	// ETag of the task as last read by the client. The change is applied only if the task has not been modified since; otherwise the server answers 412. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchTasksIdParams defines parameters for PatchTasksId.
+type PatchTasksIdParams struct {
+	// ETag of the task as last read by the client. The change is applied only if the task has not been modified since; otherwise the server answers 412. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // PostTasksJSONRequestBody defines body for PostTasks for application/json ContentType.
@@ -34,6 +490,7 @@ type PatchTasksIdJSONRequestBody = Task
 
 // GetTasksRequestObject defines request object for GetTasks
 type GetTasksRequestObject struct {
+	Params GetTasksParams
 }
 
 // GetTasksResponseObject defines response object for GetTasks
@@ -42,12 +499,22 @@ type GetTasksResponseObject interface {
 }
 
 // GetTasks200JSONResponse defines 200 JSON response for GetTasks
-type GetTasks200JSONResponse []Task
+type GetTasks200JSONResponse TaskPage
 
 func (response GetTasks200JSONResponse) VisitGetTasksResponse(ctx echo.Context) error {
 	return ctx.JSON(200, response)
 }
 
+// GetTasks400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for GetTasks
+type GetTasks400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasks400ApplicationProblemPlusJSONResponse) VisitGetTasksResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
 // PostTasksRequestObject defines request object for PostTasks
 type PostTasksRequestObject struct {
 	Body *PostTasksJSONRequestBody
@@ -65,10 +532,105 @@ func (response PostTasks201JSONResponse) VisitPostTasksResponse(ctx echo.Context
 	return ctx.JSON(201, response)
 }
 
+// PostTasks400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for PostTasks
+type PostTasks400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTasks400ApplicationProblemPlusJSONResponse) VisitPostTasksResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PostTasks422ApplicationProblemPlusJSONResponse defines 422 ApplicationProblemPlusJSON response for PostTasks
+type PostTasks422ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTasks422ApplicationProblemPlusJSONResponse) VisitPostTasksResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(422)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksTrashRequestObject defines request object for GetTasksTrash
+type GetTasksTrashRequestObject struct {
+	Params GetTasksTrashParams
+}
+
+// GetTasksTrashResponseObject defines response object for GetTasksTrash
+type GetTasksTrashResponseObject interface {
+	VisitGetTasksTrashResponse(w echo.Context) error
+}
+
+// GetTasksTrash200JSONResponse defines 200 JSON response for GetTasksTrash
+type GetTasksTrash200JSONResponse TaskPage
+
+func (response GetTasksTrash200JSONResponse) VisitGetTasksTrashResponse(ctx echo.Context) error {
+	return ctx.JSON(200, response)
+}
+
+// GetTasksTrash400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for GetTasksTrash
+type GetTasksTrash400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksTrash400ApplicationProblemPlusJSONResponse) VisitGetTasksTrashResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksIdRequestObject defines request object for GetTasksId
+type GetTasksIdRequestObject struct {
+	Id TaskId `json:"id"`
+}
+
+// GetTasksIdResponseObject defines response object for GetTasksId
+type GetTasksIdResponseObject interface {
+	VisitGetTasksIdResponse(w echo.Context) error
+}
+
+type GetTasksId200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTasksId200JSONResponse struct {
+	Body    Task
+	Headers GetTasksId200ResponseHeaders
+}
+
+func (response GetTasksId200JSONResponse) VisitGetTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Response().Header().Set("Content-Type", "application/json")
+	ctx.Response().WriteHeader(200)
+
+	return json.NewEncoder(ctx.Response()).Encode(response.Body)
+}
+
+// GetTasksId400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for GetTasksId
+type GetTasksId400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksId400ApplicationProblemPlusJSONResponse) VisitGetTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksId404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for GetTasksId
+type GetTasksId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksId404ApplicationProblemPlusJSONResponse) VisitGetTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
 // PatchTasksIdRequestObject defines request object for PatchTasksId
 type PatchTasksIdRequestObject struct {
-	Id   uint `json:"id"`
-	Body *PatchTasksIdJSONRequestBody
+	Id     TaskId `json:"id"`
+	Params PatchTasksIdParams
+	Body   *PatchTasksIdJSONRequestBody
 }
 
 // PatchTasksIdResponseObject defines response object for PatchTasksId
@@ -76,16 +638,67 @@ type PatchTasksIdResponseObject interface {
 	VisitPatchTasksIdResponse(w echo.Context) error
 }
 
-// PatchTasksId200JSONResponse defines 200 JSON response for PatchTasksId
-type PatchTasksId200JSONResponse Task
+type PatchTasksId200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchTasksId200JSONResponse struct {
+	Body    Task
+	Headers PatchTasksId200ResponseHeaders
+}
 
 func (response PatchTasksId200JSONResponse) VisitPatchTasksIdResponse(ctx echo.Context) error {
-	return ctx.JSON(200, response)
+	ctx.Response().Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Response().Header().Set("Content-Type", "application/json")
+	ctx.Response().WriteHeader(200)
+
+	return json.NewEncoder(ctx.Response()).Encode(response.Body)
+}
+
+// PatchTasksId400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for PatchTasksId
+type PatchTasksId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchTasksId400ApplicationProblemPlusJSONResponse) VisitPatchTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PatchTasksId404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for PatchTasksId
+type PatchTasksId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchTasksId404ApplicationProblemPlusJSONResponse) VisitPatchTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PatchTasksId412ApplicationProblemPlusJSONResponse defines 412 ApplicationProblemPlusJSON response for PatchTasksId
+type PatchTasksId412ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchTasksId412ApplicationProblemPlusJSONResponse) VisitPatchTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(412)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PatchTasksId422ApplicationProblemPlusJSONResponse defines 422 ApplicationProblemPlusJSON response for PatchTasksId
+type PatchTasksId422ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchTasksId422ApplicationProblemPlusJSONResponse) VisitPatchTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(422)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
 }
 
 // DeleteTasksIdRequestObject defines request object for DeleteTasksId
 type DeleteTasksIdRequestObject struct {
-	Id uint `json:"id"`
+	Id     TaskId `json:"id"`
+	Params DeleteTasksIdParams
 }
 
 // DeleteTasksIdResponseObject defines response object for DeleteTasksId
@@ -100,12 +713,198 @@ func (response DeleteTasksId204Response) VisitDeleteTasksIdResponse(ctx echo.Con
 	return ctx.NoContent(204)
 }
 
+// DeleteTasksId400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for DeleteTasksId
+type DeleteTasksId400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTasksId400ApplicationProblemPlusJSONResponse) VisitDeleteTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// DeleteTasksId404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for DeleteTasksId
+type DeleteTasksId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTasksId404ApplicationProblemPlusJSONResponse) VisitDeleteTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// DeleteTasksId412ApplicationProblemPlusJSONResponse defines 412 ApplicationProblemPlusJSON response for DeleteTasksId
+type DeleteTasksId412ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTasksId412ApplicationProblemPlusJSONResponse) VisitDeleteTasksIdResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(412)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PostTasksIdRestoreRequestObject defines request object for PostTasksIdRestore
+type PostTasksIdRestoreRequestObject struct {
+	Id TaskId `json:"id"`
+}
+
+// PostTasksIdRestoreResponseObject defines response object for PostTasksIdRestore
+type PostTasksIdRestoreResponseObject interface {
+	VisitPostTasksIdRestoreResponse(w echo.Context) error
+}
+
+// PostTasksIdRestore200JSONResponse defines 200 JSON response for PostTasksIdRestore
+type PostTasksIdRestore200JSONResponse Task
+
+func (response PostTasksIdRestore200JSONResponse) VisitPostTasksIdRestoreResponse(ctx echo.Context) error {
+	return ctx.JSON(200, response)
+}
+
+// PostTasksIdRestore400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for PostTasksIdRestore
+type PostTasksIdRestore400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTasksIdRestore400ApplicationProblemPlusJSONResponse) VisitPostTasksIdRestoreResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PostTasksIdRestore404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for PostTasksIdRestore
+type PostTasksIdRestore404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTasksIdRestore404ApplicationProblemPlusJSONResponse) VisitPostTasksIdRestoreResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PostTasksIdRevertRevRequestObject defines request object for PostTasksIdRevertRev
+type PostTasksIdRevertRevRequestObject struct {
+	Id  TaskId `json:"id"`
+	Rev int    `json:"rev"`
+}
+
+// PostTasksIdRevertRevResponseObject defines response object for PostTasksIdRevertRev
+type PostTasksIdRevertRevResponseObject interface {
+	VisitPostTasksIdRevertRevResponse(w echo.Context) error
+}
+
+// PostTasksIdRevertRev200JSONResponse defines 200 JSON response for PostTasksIdRevertRev
+type PostTasksIdRevertRev200JSONResponse Task
+
+func (response PostTasksIdRevertRev200JSONResponse) VisitPostTasksIdRevertRevResponse(ctx echo.Context) error {
+	return ctx.JSON(200, response)
+}
+
+// PostTasksIdRevertRev400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for PostTasksIdRevertRev
+type PostTasksIdRevertRev400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTasksIdRevertRev400ApplicationProblemPlusJSONResponse) VisitPostTasksIdRevertRevResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// PostTasksIdRevertRev404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for PostTasksIdRevertRev
+type PostTasksIdRevertRev404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostTasksIdRevertRev404ApplicationProblemPlusJSONResponse) VisitPostTasksIdRevertRevResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksIdRevisionsRequestObject defines request object for GetTasksIdRevisions
+type GetTasksIdRevisionsRequestObject struct {
+	Id TaskId `json:"id"`
+}
+
+// GetTasksIdRevisionsResponseObject defines response object for GetTasksIdRevisions
+type GetTasksIdRevisionsResponseObject interface {
+	VisitGetTasksIdRevisionsResponse(w echo.Context) error
+}
+
+// GetTasksIdRevisions200JSONResponse defines 200 JSON response for GetTasksIdRevisions
+type GetTasksIdRevisions200JSONResponse []Revision
+
+func (response GetTasksIdRevisions200JSONResponse) VisitGetTasksIdRevisionsResponse(ctx echo.Context) error {
+	return ctx.JSON(200, response)
+}
+
+// GetTasksIdRevisions400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for GetTasksIdRevisions
+type GetTasksIdRevisions400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksIdRevisions400ApplicationProblemPlusJSONResponse) VisitGetTasksIdRevisionsResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksIdRevisions404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for GetTasksIdRevisions
+type GetTasksIdRevisions404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksIdRevisions404ApplicationProblemPlusJSONResponse) VisitGetTasksIdRevisionsResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksIdRevisionsDiffRequestObject defines request object for GetTasksIdRevisionsDiff
+type GetTasksIdRevisionsDiffRequestObject struct {
+	Id     TaskId `json:"id"`
+	Params GetTasksIdRevisionsDiffParams
+}
+
+// GetTasksIdRevisionsDiffResponseObject defines response object for GetTasksIdRevisionsDiff
+type GetTasksIdRevisionsDiffResponseObject interface {
+	VisitGetTasksIdRevisionsDiffResponse(w echo.Context) error
+}
+
+// GetTasksIdRevisionsDiff200JSONResponse defines 200 JSON response for GetTasksIdRevisionsDiff
+type GetTasksIdRevisionsDiff200JSONResponse RevisionDiff
+
+func (response GetTasksIdRevisionsDiff200JSONResponse) VisitGetTasksIdRevisionsDiffResponse(ctx echo.Context) error {
+	return ctx.JSON(200, response)
+}
+
+// GetTasksIdRevisionsDiff400ApplicationProblemPlusJSONResponse defines 400 ApplicationProblemPlusJSON response for GetTasksIdRevisionsDiff
+type GetTasksIdRevisionsDiff400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksIdRevisionsDiff400ApplicationProblemPlusJSONResponse) VisitGetTasksIdRevisionsDiffResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(400)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
+// GetTasksIdRevisionsDiff404ApplicationProblemPlusJSONResponse defines 404 ApplicationProblemPlusJSON response for GetTasksIdRevisionsDiff
+type GetTasksIdRevisionsDiff404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTasksIdRevisionsDiff404ApplicationProblemPlusJSONResponse) VisitGetTasksIdRevisionsDiffResponse(ctx echo.Context) error {
+	ctx.Response().Header().Set("Content-Type", "application/problem+json")
+	ctx.Response().WriteHeader(404)
+
+	return json.NewEncoder(ctx.Response()).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	GetTasks(ctx context.Context, request GetTasksRequestObject) (GetTasksResponseObject, error)
 	PostTasks(ctx context.Context, request PostTasksRequestObject) (PostTasksResponseObject, error)
+	GetTasksTrash(ctx context.Context, request GetTasksTrashRequestObject) (GetTasksTrashResponseObject, error)
+	GetTasksId(ctx context.Context, request GetTasksIdRequestObject) (GetTasksIdResponseObject, error)
 	PatchTasksId(ctx context.Context, request PatchTasksIdRequestObject) (PatchTasksIdResponseObject, error)
 	DeleteTasksId(ctx context.Context, request DeleteTasksIdRequestObject) (DeleteTasksIdResponseObject, error)
+	PostTasksIdRestore(ctx context.Context, request PostTasksIdRestoreRequestObject) (PostTasksIdRestoreResponseObject, error)
+	PostTasksIdRevertRev(ctx context.Context, request PostTasksIdRevertRevRequestObject) (PostTasksIdRevertRevResponseObject, error)
+	GetTasksIdRevisions(ctx context.Context, request GetTasksIdRevisionsRequestObject) (GetTasksIdRevisionsResponseObject, error)
+	GetTasksIdRevisionsDiff(ctx context.Context, request GetTasksIdRevisionsDiffRequestObject) (GetTasksIdRevisionsDiffResponseObject, error)
 }
 
 type StrictHandlerFunc = func(ctx echo.Context, args interface{}) (interface{}, error)
@@ -130,6 +929,82 @@ type strictHandler struct {
 func (sh *strictHandler) GetTasks(ctx echo.Context) error {
 	var request GetTasksRequestObject
 
+	var params GetTasksParams
+
+	var err error
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "result_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "result_min", ctx.QueryParams(), &params.ResultMin)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter result_min: %s", err))
+	}
+
+	// ------------- Optional query parameter "result_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "result_max", ctx.QueryParams(), &params.ResultMax)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter result_max: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetTasks(ctx.Request().Context(), request.(GetTasksRequestObject))
 	}
@@ -170,17 +1045,81 @@ func (sh *strictHandler) PostTasks(ctx echo.Context) error {
 	return response.(PostTasksResponseObject).VisitPostTasksResponse(ctx)
 }
 
+// GetTasksTrash implements ServerInterface
+func (sh *strictHandler) GetTasksTrash(ctx echo.Context) error {
+	var request GetTasksTrashRequestObject
+
+	var params GetTasksTrashParams
+
+	var err error
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksTrash(ctx.Request().Context(), request.(GetTasksTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksTrash")
+	}
+
+	response, err := handler(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return response.(GetTasksTrashResponseObject).VisitGetTasksTrashResponse(ctx)
+}
+
+// GetTasksId implements ServerInterface
+func (sh *strictHandler) GetTasksId(ctx echo.Context) error {
+	var request GetTasksIdRequestObject
+
+	// Parse path parameter
+	request.Id = ctx.Param("id")
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksId(ctx.Request().Context(), request.(GetTasksIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksId")
+	}
+
+	response, err := handler(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return response.(GetTasksIdResponseObject).VisitGetTasksIdResponse(ctx)
+}
+
 // PatchTasksId implements ServerInterface
 func (sh *strictHandler) PatchTasksId(ctx echo.Context) error {
 	var request PatchTasksIdRequestObject
 
 	// Parse path parameter
-	idParam := ctx.Param("id")
-	id, err := strconv.ParseUint(idParam, 10, 64)
-	if err != nil {
-		return echo.NewHTTPError(400, "invalid id parameter")
+	request.Id = ctx.Param("id")
+
+	var params PatchTasksIdParams
+
+	if value := ctx.Request().Header.Get("If-Match"); value != "" {
+		params.IfMatch = &value
 	}
-	request.Id = uint(id)
+
+	request.Params = params
 
 	var body PatchTasksIdJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -208,12 +1147,15 @@ func (sh *strictHandler) DeleteTasksId(ctx echo.Context) error {
 	var request DeleteTasksIdRequestObject
 
 	// Parse path parameter
-	idParam := ctx.Param("id")
-	id, err := strconv.ParseUint(idParam, 10, 64)
-	if err != nil {
-		return echo.NewHTTPError(400, "invalid id parameter")
+	request.Id = ctx.Param("id")
+
+	var params DeleteTasksIdParams
+
+	if value := ctx.Request().Header.Get("If-Match"); value != "" {
+		params.IfMatch = &value
 	}
-	request.Id = uint(id)
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.DeleteTasksId(ctx.Request().Context(), request.(DeleteTasksIdRequestObject))
@@ -230,34 +1172,246 @@ func (sh *strictHandler) DeleteTasksId(ctx echo.Context) error {
 	return response.(DeleteTasksIdResponseObject).VisitDeleteTasksIdResponse(ctx)
 }
 
+// PostTasksIdRestore implements ServerInterface
+func (sh *strictHandler) PostTasksIdRestore(ctx echo.Context) error {
+	var request PostTasksIdRestoreRequestObject
+
+	// Parse path parameter
+	request.Id = ctx.Param("id")
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdRestore(ctx.Request().Context(), request.(PostTasksIdRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdRestore")
+	}
+
+	response, err := handler(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return response.(PostTasksIdRestoreResponseObject).VisitPostTasksIdRestoreResponse(ctx)
+}
+
+// PostTasksIdRevertRev implements ServerInterface
+func (sh *strictHandler) PostTasksIdRevertRev(ctx echo.Context) error {
+	var request PostTasksIdRevertRevRequestObject
+
+	// Parse path parameter
+	request.Id = ctx.Param("id")
+
+	// Parse path parameter
+	rev, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil {
+		return echo.NewHTTPError(400, "invalid rev parameter")
+	}
+	request.Rev = rev
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdRevertRev(ctx.Request().Context(), request.(PostTasksIdRevertRevRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdRevertRev")
+	}
+
+	response, err := handler(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return response.(PostTasksIdRevertRevResponseObject).VisitPostTasksIdRevertRevResponse(ctx)
+}
+
+// GetTasksIdRevisions implements ServerInterface
+func (sh *strictHandler) GetTasksIdRevisions(ctx echo.Context) error {
+	var request GetTasksIdRevisionsRequestObject
+
+	// Parse path parameter
+	request.Id = ctx.Param("id")
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksIdRevisions(ctx.Request().Context(), request.(GetTasksIdRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksIdRevisions")
+	}
+
+	response, err := handler(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return response.(GetTasksIdRevisionsResponseObject).VisitGetTasksIdRevisionsResponse(ctx)
+}
+
+// GetTasksIdRevisionsDiff implements ServerInterface
+func (sh *strictHandler) GetTasksIdRevisionsDiff(ctx echo.Context) error {
+	var request GetTasksIdRevisionsDiffRequestObject
+
+	// Parse path parameter
+	request.Id = ctx.Param("id")
+
+	var params GetTasksIdRevisionsDiffParams
+
+	var err error
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(400, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksIdRevisionsDiff(ctx.Request().Context(), request.(GetTasksIdRevisionsDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksIdRevisionsDiff")
+	}
+
+	response, err := handler(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return response.(GetTasksIdRevisionsDiffResponseObject).VisitGetTasksIdRevisionsDiffResponse(ctx)
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	GetTasks(ctx echo.Context) error
 	PostTasks(ctx echo.Context) error
+	GetTasksTrash(ctx echo.Context) error
+	GetTasksId(ctx echo.Context) error
 	PatchTasksId(ctx echo.Context) error
 	DeleteTasksId(ctx echo.Context) error
+	PostTasksIdRestore(ctx echo.Context) error
+	PostTasksIdRevertRev(ctx echo.Context) error
+	GetTasksIdRevisions(ctx echo.Context) error
+	GetTasksIdRevisionsDiff(ctx echo.Context) error
 }
 
 // RegisterHandlers adds each server route to the Echo instance.
 func RegisterHandlers(e *echo.Echo, si ServerInterface) {
 	e.GET("/tasks", si.GetTasks)
 	e.POST("/tasks", si.PostTasks)
+	e.GET("/tasks/trash", si.GetTasksTrash)
+	e.GET("/tasks/:id", si.GetTasksId)
 	e.PATCH("/tasks/:id", si.PatchTasksId)
 	e.DELETE("/tasks/:id", si.DeleteTasksId)
+	e.POST("/tasks/:id/restore", si.PostTasksIdRestore)
+	e.POST("/tasks/:id/revert/:rev", si.PostTasksIdRevertRev)
+	e.GET("/tasks/:id/revisions", si.GetTasksIdRevisions)
+	e.GET("/tasks/:id/revisions/diff", si.GetTasksIdRevisionsDiff)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8STQY/TMBCF/0o0cIw2XeCU2wIS6m0P5YRWyGtPWy+ObewJKKr839GMA1uarhYu3UuT",
-	"zrMz730eH0CHIQaPnjL0B8h6j4OS143K3/gZU4iYyKJUreHfbUiDIuhhtJ6gBZoiQg/WE+4wQWnB5q8m",
-	"eOTFs3gfgkPlWUyYR0dHWqZk/Y4lmrueCOVPj3D/gJqgcMn6bZDFlhxrN7draOEHpmyDhx6ur1ZXK/5q",
-	"iOhVtNDDWym1EBXtJVDHHeVth2KJ0yqywa8N9PAJaSMLxHUMPlcOb1YrfujgCb3sUzE6q2Vn95C5/2+c",
-	"wo1wkI2vE26hh1fdI/hupt4J8seoKiU11aQGs042Us110zibqQnbpprnFXkcBpWmarlRzs0aI91l6L9A",
-	"/X9XWoghn4l6G/JR1u8jZnofzPRfMZ9Pt0yz2aN4bSg0OqEinA3YhAZ6SiOWBf3ri9iqdozYO6H8QaRG",
-	"NR5/Vn1JurTzeHUHawobMeiQcMn+o9SF/trIeCY1IGHi7x3AsiEeWWjBq0HumllQao8SP3dDy90C6btq",
-	"8C8GfCzVs2nyqDXmvB2dm05gVPuNegqE3De9PzNzXL547JeZ7TGaf5vt1UVsVTtnZ/uzSE8fZynlVwAA",
-	"AP//FYOsWjkGAAA=",
+	"H4sIAAAAAAAC/+x86XIjN5Lwq2TUNxFWt0sSqaNts+P7YfcxowmP3dHHNxGfKFNgVZLEdBVQDaB4uFcT",
+	"+3MeYB9gnm2eZCMB1EWCh9Tt9u7G/pGKVSggM5F3JupjlMi8kAKF0dHgY1QwxXI0qOyvl6VIDJfiJ5Yj",
+	"/U5RJ4oXdCsa1E9B0OM44nSzYGYWxZG9NYj8E4UfSq4wjQZGlRhHOplhzmhGsyponDaKi2l0dxdHV5O/",
+	"MJPMNpd78ZZNQU7AzBAM0++BaciYNqCQpTBe2QdJxlGYE3hL1zMmpghcAyuKjGMKUmQr4K0pZkyDkAbG",
+	"iAJymfIJDdNcJPgUpJmhWnCNdrxGNUcFTOgFKg0X/bMTGEaPhxHkBC9qYGIFc1SaS3EyFBVBZshSVA1J",
+	"ribHDsHdZHjL9PurdJMKdB94isIQrGoADN69u3oeg1TAIMWE5ywDUeZjVDCRChQmUqUaEoXMYE2oDKcs",
+	"WcGbF6+vvv8RHCQtqLvbyNN7buL/Y4qzcYZhxqmefk7GuaPBupBCo2XdH1j6Gj+UqA39SqQwKOyl5YWE",
+	"ESinhZLjDPOv/6YJro+t6f+gcBINov9z2ojHqXuqT1+5t9yia7szQ1BuWdoQIjQuC4WamAK4AG6IH7mY",
+	"s4yn0V0cPZNikvHkd4My8etrWHAzA1xybbiYQsoMI/heSjXmaYriSwOYsCxDBTlbWQktUE2kysHMuAZZ",
+	"oLJLE4Q/SfNSliL98hTUslQJQirRaRFLPNr3MWZSTDUYCUxYNQKlRkXQviJ5FCmniV4ynuEXh9sqvgXT",
+	"a/rOMqvVsVxApaTsOK51iZZZ3wlWmplU/NcvC/ZfuNbElFJVokPqzCpBlmkHWaFkglqTXnkhDDerL03X",
+	"tqBrcFCOSwMJE87EAM5ZVpIStjrST+uUldda3Vlfoy4zU9m8QsmpYnlOQiFTpG3COaoVjJnGpyBwygyf",
+	"o9f9GphCWChuDAoylWaGXIFZyK/0MSGXYY7CwJgbKJgxqAQtdLuQKh2Rcr2FBU/NzNmEQpHIGe6gHHNL",
+	"NVwymiYaRL1xv9/v9/q9fhSva2YiVdIdftwPjpvhcm3a5eQyNFAmZm2gPH8SGGltAkt/FtmqMiCNQbm2",
+	"aLjJHIwOgpt6Gjn+GybGK2ltmOOjLik6G/ZxE1ThDWADa8FDKBFnBCzlc2/P7WOnoZ9cgOZTwSc8YcJA",
+	"yqfc6C24N7h6e+qWCaH4YuncpdfM4CaaSakUimTVReXFu9fEG457okH0y/X3x///5uP53R+CbMBMCEPm",
+	"/BHFDKmm96gBJxNMDEgBR+/ePnsUxRFpfmaigZsjMLcKzv1OcKMr8alQIEsCUiCUgteyRSJUj4jiNmud",
+	"fHe2l7oerNYEFqAQnSun+UpMZIDOzOBUKkdnUeY0uVF8KoXM0SiaebYqUI1lxolhMzlliptZTktKaey/",
+	"UqQEZEyu/ZgLZqTiiXa/iwyXURxZa3QTlNUJF7xi53V/P8ugGfAUCoUahbFuJs0IE4+ctp52WBfslpec",
+	"LUdMTQPK8C9syfMyr1xbOQGmpmWOwuinwMY1IHNyLlOeNMA0cHBhcOrscM5FvVDgqUxDCpmMN7ca3g4g",
+	"FbyY8WRmeahazwYcc8YzMkVRHHGDuQ4i628wpdgqrCwyOT1UtGvWaeHWpXeFVogtf5RTLloOc5ctMWc8",
+	"o4taEN2dwAYXTGuyIeG4oA13NUX9RgiuyuZuRoNKSQUpGsYzDUevXz6Db77tffMoBoWmVAJTMnrbjD5F",
+	"Qc54okgLyYUJ2blEphhixGTGBR6TabFRDFpQaPAJXPR6g8pHGXkfO65vJKXSUsWgV8Kw5ci+GEMp3gu5",
+	"EKO5D4uaOxVLNXdIacWwUFJMRxX/jxJZCtOMQTHlws6iy6KQymA6op1v4CgqPm5u1Xa/Ba1TFyPa9OYu",
+	"E9MMPRzVPZ421w3MCglfPsfWvWpUheuIuJeG2vB64/7GcGvAmvuKGYxbntfISDki3zsGusqZWI2MfI9C",
+	"b4xKEQvar/4AypZb20K/8TCbm3Yyeu18AJMqNKLfFwMKAUYT0r70+7sBsIxYZDWyYYGOawUx4mJUamKW",
+	"/tmAlGgdEIwmNiI4gYuzswGkfG43aTRejX5FJWOQc1STTC5iSGXOuKg4iFbGJUtMDCnPUdi3cq5tZiKu",
+	"/E6LOc9RlsZSvMyMoxdTUzyBS8LJP3fS0OihNsOG1brxGqIrK38qcyZakrIsMiYsJM7yUiyXeLMZNOzc",
+	"Ol4JhpxjF8BS3qDyj0m+qwkp2xOaUU4mGs3mfM9mTLHEWNNCI0i5r8XvixkqFyc5kafgyG54m1YXIWuj",
+	"DTOl7uj2i14vNNJwkwWwfTOTyoAu85ypVeW1/Ont21fgp27v1g8shUqXByhgeTiQWaLbFGKR6AEzcOsI",
+	"cduZ+3FwRntjw/16fQUKJ2g3t8pZrSiOa2+X0zmVdtanH0mR3nXWbB7u5sM1A2OfVhSt9yB2aj1kbMj3",
+	"vcpJY26aQMWMu6jN+a5QseNNO2/jyr3XXzf7a0C7dXZD5+LCTRjJi+36D+/ePA9KVe7MQkCqaHnQRipM",
+	"n3bd4jqTyBSCfs+LAtOAa7WGT71U7MDbhtlb6y09HKUqujgoVLBTs9QpXZa96iy522dtggpkyeyBUcVH",
+	"GzrVwQWhNIj60d0GbdaIaakRV6jtYBWcKNSzrf6ccs9HtSbYLUjd4eEFnanaXKpxF4K0dYZ3xAOsePW8",
+	"oqONLRYzCTlLnf510vUUMC+Mzeh73ye04W03JgiDz4yPmNlgoGOyh6FJnY8VnK4xGMHHTVhyHx7coHju",
+	"fdONkS4+CtlLt0U+gIrJcCib6mUG+sEYqXYTw0HS/SVpAwtVa7KNsZWD4k3LxvOtLFX5ip8IWu0Q75cP",
+	"T/LO1te4dfhrl+w8szy9KUETjlkaZiUlwxxt5H6g3bR+EvvKLuCe88lkEzQnhoFA+SVNTjlHRhmqyQTV",
+	"CVRVHw1MpK1UAZkTklGmXHXKuR8ahs1OngzLXu88oSf2CoeRnWTYSFNgyFNgwmsIlz/LkQlt9Yddg+sq",
+	"b2BdPWZAeWx9IewQS7+2e4GovqsPAj7XcYZzzABTbsA9BIpgK0fplnbotltHMtI9MrL94AFgv0i5CQG9",
+	"xlptB1WG7q8zV81Ucc0kHUrs4jUL0wavyaKdFMMPJcsiGx+gcgncDDspt5Y04NLslwdZRH5oCDYqu+4z",
+	"bpt+gjVgTfqulZRqkleWkbmYo9KtHJJTHvoEXlj+lcLXbz0PK5ZyJnRlAKWAsiB7Be8RC13Xt7/S1h/x",
+	"0ZwnnX/X0myqsONCNEQbVyWJXVzk6hYBG7sWBcqFs9pulCtfUGkCdX3PIwxHKU4YVT0UJoaJaZkx9ahF",
+	"hm140spreDYTRHFUSPofwvQA879eRQi4iBk2c3Sx/+sMRQ2pL7zNMQUvw0YxPetkMDM+d4N1FD8QnsY9",
+	"WcuaNUrEDSHfyoJS1aZqSDdZT2OGVChudUP4zdrFh36dDgGqxVJb0lhPNkylfxxyvB7mPq1XVaoctu64",
+	"mI1NWgv8bS06jX0ZbQULVDZCbhIrNnESA55MqStkcrSMYfUI/i8sfzmDr2E1jBySWzau0TPOEd67v1yP",
+	"UtnxP8dSZsiEy6EbxZcBD1AuLL4M3Agvcx3ec8Vq98CG5vqkvTfX18dncf8mvu6fXMbHvZPLm5uWwakv",
+	"GqaVpUuCeyi9pxQwOFtQrp/nwVzsi2YDcpt/HUaTTDIzjGhHNdgfTy6ewjByDQMsG0aeOW3CDCaK+T0/",
+	"6p+ek1O80tA/PX9E7/h2mmEEtqpiC/q3tVd8GyjE0VukcvUwqrlcQzFbaZ6wDD6UTBhObOqKeW+unPrP",
+	"C1ScZVZbazgaRpfwPodTOOtBTs6JhPf56WwYPYohmWHy3nZoVJk+HdtJcilwRa6MjzjrRaoQHNBnJWyx",
+	"zS7TP+vBuzfP4Ws4v4QX717T63/84dUwekTsXdXlbKHDV+UWHW3GW7JMqDc16g7+3k3QmxVmM4OFYsUx",
+	"swRuJTh7y9Pe+LQnIeMGlc3Bjrmx3Vjk3509gX+DX+DvMegZnxgNzv1zf8F7gPZvDIVcoILHjy2VlMxO",
+	"lVSnhSxs2pyo0Fu+fFnN+vfemArZ3fnOhpHlB2+sOrj5e/2zb+vKu6c6m3JB2boKA7s+ka55ZLMFnGA4",
+	"Ov/6gj96fNQ/PuOPhlEMw0h/UObo+ML/YmPtxnhYnAx3QJljYqRy69jHCep2C4BATWyQcV21+6Tosvgx",
+	"mSGhC6nRXiZIKWYTQ6KkdhMKqXIC9Pq6H5/dxNfn8cXNDTyG68v4yY3lTEpeE2O6mTfy0I51NP8VNaTS",
+	"NsxMuKnMTNu4OJPxla7Mi5PtjnG3gh3FtVRHcSWskQsNdRS3uLFT/PT6MeQLdCLetQzshqxbhUnw+pWd",
+	"X1M7MOcXj07sarZuGQ36Pcr45lz4n/FnCanX7XtHwrulb1IIa4at1AeYtQGUD8x5HWj0mixAsP+FwMOl",
+	"eQoz8iOl6/fkBlIskJSyFHDbyhbculX3JRS6Sw29aRpGNiykNeouyqNhdOFksH967i7OTi7x+JsNrWBf",
+	"9T+rtyv54wJu227y7YAmJHEfRpR1H0aX//rHPykj+M3Zd5dn/W97vf6T/tkwgqNcpmVW6n/9459V1BBb",
+	"Wle/7NxNLHJroXLKoA6Ua3XBFMKf3/z8E1jTaq3Adf+b+Py7G4daR76tXLNMy6aqqo0qE1NSsE7LumVu",
+	"7Sq3bpHbrqjW2ZFGAt1LHVHc6/IYH4HlbPkjiqmZRYOzy8t4e2JoSzjmOveIr3yBxzG3K5B6/80Z29ox",
+	"qm1e9SJtc5ExXiXT1j1Yev8QN905y4eGDb7Z+bi2uA8OD6p1x6uD0q5V67BUDganY9IauoOW1FileT9T",
+	"xm69pzgrG4037+SaEt++taH+6urUfiV4oCrznL3pqLp2O+9/u1EP8b+dpMb3crX3uda+Zz3ACiJRFmza",
+	"+6rb0G2+67C37aKuwb3TmZ8wpSpro1mO3bb4LfC0zGAn97qeWVxiemxbEyu30tKpNsbrbZK1PebCPLk4",
+	"JJVA03VVGBfm2yiOSv+fC9N/4n/bCy7M+Zm/YS/sWv7Gk4uAq7FeqiQp2pZ3esVCaeHNqGtXpobmCbYc",
+	"4dL4tpRASdzer3aWhkLBqOwiqBNMOvVkNQLdjuKI7rs6XlALrCHtAA9iTWnRV4yrTbRZkqDWWytXNsnI",
+	"FeoRDzD09/ZlsC9DxidIkk12QNsejHCn2L5imS+o1/zaqsMjU7i/Tt1BaX29zuwd7EKEe6cxQLMHFbiq",
+	"lq+NJ1uUONcjluaO6lskvJWj6Fq+Q0C624Ju1VAZqB3LdK1h1Wdjot8sC9hunmyW3UgHHTbZfTuLJ+F+",
+	"PMXybufJdUQ+2Cq6uU9v4gEbtgelcNuihy92u3WzZ5O3VrSrvd6a7qTMgrMP9Qm3uHET4paPQB6DM8NN",
+	"cQluf7m1yY6lU6zcOgYn8Nq1uLmmT4poWZbJBaZbQpBD93TNDNenvaC05xtsOqZGTT+FvNTGLq9nLJUL",
+	"YDAueWaOuahxAKlqHKO47UU/udjo4WbHv45u/EXv+LvRzeM/7Oatmo8eNtHOjpgHMcq7Ig02sld8cu+t",
+	"+e1wDeLxuVtxcy7q/Y4/R2NuVc19kLX5DZSfYctob4T1MGDqsxn1ar2Ts3i/533fIxgVSbfu/ZdTH59b",
+	"U9Qk/M2Itk3g99HswZBtA+kujjRZBW5Wb8j59nrHuoLfl2bW/HpZrfjnv76tTgNbN2nNbZwZU7hDZ9wf",
+	"GvFNotH3r66iVvwW9U96Jz3CShYoWMGjQXRub9l9m1lITl1lcfAxmmIw52ZK5Stg7ggmRUT0ChyxLPOX",
+	"FGwxAdbh49ooZqR6ZHOBFAlQ+Mxc1AyvmNZw2wozboGaA3yohnMuS+1f0nBbDTESpmiasEMKjG2gVjVF",
+	"2KhSS2VcMwnPDCrtrG59OJSOT0d/RPPWl1LbZ9uvN452EAiUnK7OI38o0R6mcEIVZTznpnNm28eV0eCy",
+	"18rynu1L8t7F60v/XLAPpU2aUrRlqdMlmJxskmsLmO6V3WfLNzLbRMb3uBq4RE9TzfQRtShzpN4F10TT",
+	"zaEdOX5YzKRup8esKwTWG64OJFJe1EWLj1rnzNfApx0NE7ltVprAvHPTLR8Mtj8GV5PKncwPLUckai3E",
+	"7C97Mzz/WuTMNB5zoVFobg9l6nLsRlf067SKhYD70AFsT9ZzE4If5QK1qffO0YZqPUlWaj7fxj8+SZ5z",
+	"0Vn+AOW40fTBp7NPgYAtPxWCYaRLG1oPI/jXv/+HV122OsXq3Nswss3kG0NkabUOHFVdzxlOjD02xFRG",
+	"ttRrXd/k5jqmwZ3fcNPsYvOqFb3Br2I0D3IUO7gO4rYXFiRKzHbldx+pK+nx3VohYu+KxzdYjpkAELg8",
+	"DAgj7w/CzdpHH856vR2nvu932rvOvAWOe3/vLJacuJ0mi3vR622bsQbxtPVRCprUH+dwVgrY2qRxZNhU",
+	"V9lBHd2QSy+dX9i1ca+kro2cP3P2g49zPhspdn5NwFQ1go1Pdtxt7FD/i4BVlSyMz3vee3vi6OLsbP8r",
+	"oe8OdLf2mYUEGAhcVNWS9Y29i71bdmq7we7nnPnes7aT1r0VdNZiyKUtJyUoTLaqX5lwpc0JPO/MwBRC",
+	"USqq+RSocibcO2xiULXawb7SoJD2laS/QMVlussle2tx/V+/7L+MMuuwzedWat3Jd8nAR57euY2kNwIH",
+	"cOUcm4LNWh8ltx/cgLH1Rp1Rthb/1c9v3kJrgVP/GEpheOa/y+N4PMSzTh4s216lmzwbolAz5NR/zOku",
+	"3juy+vpVgBsutnwMarOb9KEKr3ex/5X6azv0Qv8ADRn44E2XTRxpgW1TjnGlC8Na5BO247eWuD3fXqrM",
+	"U+y/EWZhoJJqsBKnUJjK6WwXW2Or3w/6qtjdF+GLgA6wcjpewdXzsFdTffBtza2h219a5H4f78mlKQ/w",
+	"nr4Mg/qs6f9I9vwEtfXZfEKXrNyu8rq2sDJVtHIVAOy0iDZ2nTT2gOpUNIM97OeG2KDVJ2q4aIb6Pi9N",
+	"BrEUvtEnZBDrmOMqfe3B+++nhr2D8ClBwifoRk83+9nGxjc6hB3mqMzpR4Xzu+084Wdf73mqvvxg86at",
+	"HieNhg6D6ia/54+LVsmQpvGM2Ij7b2w6WIiTXJ7Ef3bFBjvd03Q72IemeI3zT9HxO8+6hj8xqXC+oXA7",
+	"KbddwcLvx7NErN+TZ+1+s9pysXqbD2JcO3J/9cG1mtU82LEnMktRmypQteE1pw++WKNlVZxIPZ1aCs92",
+	"LrM0bQH8tL5yEa6gd2CHzmtcztc1Jr+X0rvXKc9A3XmDu2qU2tSOfh+PsaOAZpwU2cp1Th6sIR0up6k/",
+	"t7wnhKiRt+ecP5se+qn+VhlhRIyr2uISShT4XOwD1dIeCAQu9kNg5MPX/y3VYucoeoCBn/OJ7+bVMEaz",
+	"wLppu5LVL8/Mz9y5dvrsZkvZ7OTkVv3Y8l67cnx9c3dz958DAAVB/0OzWwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
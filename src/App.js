@@ -2,7 +2,91 @@ import React, { useState, useEffect } from 'react';
 import axios from 'axios';
 import './App.css';
 
+const API_URL = 'http://localhost:8080';
+
+// Attach the access token to every request
+axios.interceptors.request.use((config) => {
+  const token = localStorage.getItem('accessToken');
+  if (token) {
+    config.headers.Authorization = `Bearer ${token}`;
+  }
+  return config;
+});
+
+// On 401 try to refresh the token pair once, then repeat the request
+axios.interceptors.response.use(
+  (response) => response,
+  async (error) => {
+    const original = error.config;
+    const refreshToken = localStorage.getItem('refreshToken');
+    if (error.response?.status !== 401 || original._retried || !refreshToken) {
+      return Promise.reject(error);
+    }
+    original._retried = true;
+    try {
+      const { data } = await axios.post(`${API_URL}/auth/refresh`, { refresh_token: refreshToken });
+      localStorage.setItem('accessToken', data.access_token);
+      localStorage.setItem('refreshToken', data.refresh_token);
+      return axios(original);
+    } catch (refreshError) {
+      localStorage.removeItem('accessToken');
+      localStorage.removeItem('refreshToken');
+      window.location.reload();
+      return Promise.reject(refreshError);
+    }
+  },
+);
+
+function Login({ onLogin }) {
+  const [email, setEmail] = useState('');
+  const [password, setPassword] = useState('');
+  const [error, setError] = useState('');
+
+  const handleSubmit = async (event) => {
+    event.preventDefault();
+    try {
+      const { data } = await axios.post(`${API_URL}/auth/login`, { email, password });
+      localStorage.setItem('accessToken', data.access_token);
+      localStorage.setItem('refreshToken', data.refresh_token);
+      onLogin();
+    } catch (err) {
+      setError('Invalid email or password');
+    }
+  };
+
+  return (
+      <div className="App">
+        <h1>Calculator</h1>
+        <form className="login" onSubmit={handleSubmit}>
+          <input type="email" value={email} onChange={(e) => setEmail(e.target.value)} placeholder="Email" />
+          <input type="password" value={password} onChange={(e) => setPassword(e.target.value)} placeholder="Password" />
+          <button type="submit">Log in</button>
+          {error && <div className="error">{error}</div>}
+        </form>
+      </div>
+  );
+}
+
 function App() {
+  const [loggedIn, setLoggedIn] = useState(Boolean(localStorage.getItem('accessToken')));
+
+  const handleLogout = async () => {
+    const refreshToken = localStorage.getItem('refreshToken');
+    localStorage.removeItem('accessToken');
+    localStorage.removeItem('refreshToken');
+    setLoggedIn(false);
+    if (refreshToken) {
+      await axios.post(`${API_URL}/auth/logout`, { refresh_token: refreshToken }).catch(() => {});
+    }
+  };
+
+  if (!loggedIn) {
+    return <Login onLogin={() => setLoggedIn(true)} />;
+  }
+  return <Calculator onLogout={handleLogout} />;
+}
+
+function Calculator({ onLogout }) {
   // State for current expression, result, history, and editing ID
   const [expression, setExpression] = useState('');
   const [result, setResult] = useState('');
@@ -17,8 +101,8 @@ function App() {
   // Function to fetch history (GET request)
   const fetchHistory = async () => {
     try {
-      const response = await axios.get('http://localhost:8080/tasks');
-      setHistory(response.data);
+      const response = await axios.get(`${API_URL}/tasks`);
+      setHistory(response.data.items);
     } catch (error) {
       console.error('Error fetching history:', error);
     }
@@ -43,12 +127,12 @@ function App() {
       let response;
       if (editingId) {
         // Update existing calculation (PATCH)
-        response = await axios.patch(`http://localhost:8080/tasks/${editingId}`, {
+        response = await axios.patch(`${API_URL}/tasks/${editingId}`, {
           task: expression,
         });
       } else {
         // Submit new calculation (POST)
-        response = await axios.post('http://localhost:8080/tasks', {
+        response = await axios.post(`${API_URL}/tasks`, {
           task: expression,
         });
       }
@@ -72,7 +156,7 @@ function App() {
   // Function to handle delete (DELETE request)
   const handleDelete = async (id) => {
     try {
-      await axios.delete(`http://localhost:8080/tasks/${id}`);
+      await axios.delete(`${API_URL}/tasks/${id}`);
       fetchHistory(); // Refresh history
     } catch (error) {
       console.error('Error deleting calculation:', error);
@@ -91,6 +175,7 @@ function App() {
   return (
       <div className="App">
         <h1>Calculator</h1>
+        <button onClick={onLogout}>Log out</button>
         <div className="calculator">
           <div className="display">
             <input type="text" value={expression} readOnly placeholder="Expression" />